@@ -0,0 +1,298 @@
+package dateparser
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	_STYLE_NONE int = -1 + iota
+
+	_STYLE_OLD
+	_STYLE_NEW
+)
+
+// The Julian Day Number of 1 January 1970 (Gregorian).
+const _UNIX_EPOCH_JDN = 2440588
+
+// A calendar in which the dates in the input may be written. time.Time always
+// uses the proleptic Gregorian calendar, so dates in other calendars are
+// converted to it.
+type Calendar interface {
+	// Returns the date in the calendar that falls on the given proleptic
+	// Gregorian date.
+	FromGregorian(year int, month time.Month, day int) (y int, m int, d int)
+
+	// Returns the proleptic Gregorian date that falls on the given date in
+	// the calendar.
+	ToGregorian(year int, month int, day int) (y int, m time.Month, d int)
+}
+
+type gregorian struct{}
+
+func (gregorian) FromGregorian(year int, month time.Month, day int) (y int, m int, d int) {
+	return year, int(month), day
+}
+
+func (gregorian) ToGregorian(year int, month int, day int) (y int, m time.Month, d int) {
+	return year, time.Month(month), day
+}
+
+func (gregorian) String() string {
+	return "Gregorian"
+}
+
+type julian struct{}
+
+func (julian) FromGregorian(year int, month time.Month, day int) (y int, m int, d int) {
+	return julianFromJDN(gregorianToJDN(year, month, day))
+}
+
+func (julian) ToGregorian(year int, month int, day int) (y int, m time.Month, d int) {
+	return gregorianFromJDN(julianToJDN(year, month, day))
+}
+
+func (julian) String() string {
+	return "Julian"
+}
+
+type switchover struct {
+	first time.Time
+}
+
+func (cal switchover) FromGregorian(year int, month time.Month, day int) (y int, m int, d int) {
+	if time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Before(cal.first) {
+		return Julian.FromGregorian(year, month, day)
+	}
+
+	return year, int(month), day
+}
+
+func (cal switchover) ToGregorian(year int, month int, day int) (y int, m time.Month, d int) {
+	fy, fm, fd := cal.first.Date()
+	if year < fy || year == fy && (month < int(fm) || month == int(fm) && day < fd) {
+		return Julian.ToGregorian(year, month, day)
+	}
+
+	return year, time.Month(month), day
+}
+
+func (cal switchover) String() string {
+	return "Julian/Gregorian switchover at " + cal.first.Format("2006-01-02")
+}
+
+var (
+	// The proleptic Gregorian calendar, used for all dates. This is the
+	// default.
+	Gregorian Calendar = gregorian{}
+
+	// The proleptic Julian calendar, used for all dates. Years are
+	// astronomical as in the Gregorian calendar, and begin on 1 January.
+	Julian Calendar = julian{}
+)
+
+// Returns a calendar that uses the Julian calendar for dates before first, and
+// the Gregorian calendar from first onwards. first is the first date of the
+// Gregorian calendar: 15 October 1582 where it was introduced, or 14 September
+// 1752 in Great Britain and its colonies. Only its year, month and day are
+// used. Dates written in the gap left by the switch (such as "10 October 1582")
+// are taken to be Julian.
+func Switchover(first time.Time) (cal Calendar) {
+	y, m, d := first.Date()
+	return switchover{time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// Returns a / b rounded towards negative infinity.
+func floorDiv(a int, b int) (q int) {
+	q = a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
+
+// Returns the Julian Day Number of a date in the proleptic Julian calendar.
+func julianToJDN(year int, month int, day int) (jdn int) {
+	// Count years from March, so that the leap day is at the end of the year.
+	a := floorDiv(14-month, 12)
+	y := year + 4800 - a
+	m := month + 12*a - 3
+
+	return day + floorDiv(153*m+2, 5) + 365*y + floorDiv(y, 4) - 32083
+}
+
+// Returns the date in the proleptic Julian calendar of a Julian Day Number.
+func julianFromJDN(jdn int) (year int, month int, day int) {
+	c := jdn + 32082
+	d := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*d, 4)
+	m := floorDiv(5*e+2, 153)
+
+	day = e - floorDiv(153*m+2, 5) + 1
+	month = m + 3 - 12*floorDiv(m, 10)
+	year = d - 4800 + floorDiv(m, 10)
+
+	return year, month, day
+}
+
+// Returns the Julian Day Number of a date in the proleptic Gregorian calendar.
+func gregorianToJDN(year int, month time.Month, day int) (jdn int) {
+	unix := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+	return int(unix/86400) + _UNIX_EPOCH_JDN
+}
+
+// Returns the date in the proleptic Gregorian calendar of a Julian Day Number.
+func gregorianFromJDN(jdn int) (year int, month time.Month, day int) {
+	return time.Unix(int64(jdn-_UNIX_EPOCH_JDN)*86400, 0).UTC().Date()
+}
+
+// Returns the calendar that marks the style of a date ("O.S." or "N.S.").
+func styleCalendar(style int) (cal Calendar) {
+	if style == _STYLE_OLD {
+		return Julian
+	}
+
+	return Gregorian
+}
+
+// Sets the calendar that the input is written in. Returns false if the input
+// has already named a different calendar.
+func (res *parseresult) setCalendar(cal Calendar) (ok bool) {
+	if res.calendar != nil && res.calendar != cal {
+		return false
+	}
+
+	res.calendar = cal
+	return true
+}
+
+// Returns the calendar that a result is written in: the one named in the
+// input, or else parser.Calendar.
+func (parser *Parser) calendarFor(pres parseresult) (cal Calendar) {
+	switch {
+	case pres.calendar != nil:
+		return pres.calendar
+	case parser.Calendar != nil:
+		return parser.Calendar
+	}
+
+	return Gregorian
+}
+
+// The month names and eras of a calendar other than the Gregorian one, such as
+// the Hijri calendar.
+type CalendarVocabulary struct {
+	Calendar Calendar // The calendar the names belong to.
+
+	// The names of the months, from the first month of the calendar's
+	// numbering. A name may be made up of several words, which may be
+	// separated by spaces, hyphens or apostrophes in the input (as in
+	// "Rabi' al-Awwal" and "Rabi al Awwal").
+	Months [][]string
+
+	// Names of the era of the calendar, as in "1424 AH". A year written with
+	// one of these is in the calendar. They may also be written with full
+	// stops between the letters.
+	Eras []string
+}
+
+// The lookup tables for a calendar, built from a CalendarVocabulary and
+// passed to Parser.Calendars.
+type CalendarInfo struct {
+	Calendar Calendar
+
+	month *stnode
+	era   *stnode
+}
+
+// Returns the letters of s in lower case, leaving out spaces and punctuation,
+// so that "Rabi' al-Awwal" and "rabi al awwal" are looked up alike.
+func calendarKey(s string) (key string) {
+	var b strings.Builder
+	for _, char := range s {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			b.WriteRune(unicode.ToLower(char))
+		}
+	}
+
+	return b.String()
+}
+
+// Builds the lookup tables for a calendar vocabulary.
+func NewCalendarInfo(v CalendarVocabulary) (info *CalendarInfo) {
+	var months []stinput
+	for i, names := range v.Months {
+		for _, name := range names {
+			months = append(months, stinput{calendarKey(name), i + 1})
+		}
+	}
+
+	return &CalendarInfo{
+		Calendar: v.Calendar,
+		month:    stBuild(months),
+		era:      stBuild(appendWords(nil, v.Eras, 0)),
+	}
+}
+
+// Returns whether the token may appear between the words of a month name.
+func isNameSeparator(token string) (r bool) {
+	return token == " " || token == "-" || token == "'" || token == "’"
+}
+
+// Returns the calendar and number of a month name from parser.Calendars
+// starting at tokens[i], and the index of the token following it. The month
+// is _MONTH_NONE if there is no such name.
+func (parser *Parser) calendarMonthAt(tokens []string, i int) (cal Calendar, month int, next int) {
+	if len(parser.Calendars) == 0 || !hasLetter(tokens[i]) {
+		return nil, _MONTH_NONE, i
+	}
+
+	// Find the ends of up to four words, each separated from the last by
+	// no more than two separator tokens.
+	var ends []int
+	j := i
+	for len(ends) < 4 && j < len(tokens) && hasLetter(tokens[j]) {
+		ends = append(ends, j+1)
+
+		k := j + 1
+		for k < len(tokens) && k < j+3 && isNameSeparator(tokens[k]) {
+			k++
+		}
+		if k == j+1 {
+			break
+		}
+		j = k
+	}
+
+	month, next = _MONTH_NONE, i
+	for _, info := range parser.Calendars {
+		key := ""
+		start := i
+		for _, end := range ends {
+			for _, token := range tokens[start:end] {
+				key += calendarKey(token)
+			}
+			start = end
+
+			if m := info.month.search(key); m != -1 && end > next {
+				cal, month, next = info.Calendar, m, end
+			}
+		}
+	}
+
+	return cal, month, next
+}
+
+// Returns the calendar whose era (from parser.Calendars) is named by the tokens
+// starting at tokens[i], and the index of the token following it, or nil.
+func (parser *Parser) calendarEraAt(tokens []string, i int) (cal Calendar, next int) {
+	for _, info := range parser.Calendars {
+		if era, next := dottedWordAt(info.era, tokens, i); era != -1 {
+			return info.Calendar, next
+		}
+	}
+
+	return nil, i
+}
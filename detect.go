@@ -0,0 +1,43 @@
+package dateparser
+
+// Returns the vocabularies in parser.Languages that the input could be written
+// in, in order of preference. A vocabulary is a candidate if it recognises
+// every token that is a month or weekday name in any of the vocabularies.
+func (parser *Parser) detectLanguages(timestr string, tokens []string, spans []span) (candidates []*ParserInfo, err error) {
+	candidates = append(candidates, parser.Languages...)
+
+	for i, token := range tokens {
+		if isPunctuation(token) {
+			continue
+		}
+
+		var matching []*ParserInfo
+		for _, info := range parser.Languages {
+			if info.month.search(token) != _MONTH_NONE || info.weekday.search(token) != _WEEKDAY_NONE {
+				matching = append(matching, info)
+			}
+		}
+
+		if len(matching) == 0 {
+			continue
+		}
+
+		var remaining []*ParserInfo
+		for _, info := range candidates {
+			for _, other := range matching {
+				if info == other {
+					remaining = append(remaining, info)
+					break
+				}
+			}
+		}
+
+		if len(remaining) == 0 {
+			return nil, parser.errorAt(timestr, ErrMixedLanguages, "Words from different languages found", token, spans[i])
+		}
+
+		candidates = remaining
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,334 @@
+package dateparser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A calendar-aware duration, as returned by ParseDuration. The years, months
+// and days are kept separate from the clock time, as their lengths depend on
+// the date the duration is added to.
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration // Hours, minutes and seconds.
+}
+
+// The order in which the components of an ISO 8601 duration must appear.
+const (
+	_DURATION_YEAR int = iota
+	_DURATION_MONTH
+	_DURATION_WEEK
+	_DURATION_DAY
+	_DURATION_HOUR
+	_DURATION_MINUTE
+	_DURATION_SECOND
+)
+
+// The lengths of the time components of an ISO 8601 duration.
+var clockUnits = map[int]time.Duration{
+	_DURATION_HOUR:   time.Hour,
+	_DURATION_MINUTE: time.Minute,
+	_DURATION_SECOND: time.Second,
+}
+
+// Returns a ParseError describing a bad duration.
+func durationError(s string, why string, start int, end int) ParseError {
+	return ParseError{
+		Timestr: s,
+		Why:     why,
+		Where:   s[start:end],
+		Start:   start,
+		End:     end,
+		Err:     ErrBadDuration,
+	}
+}
+
+// Parses an ISO 8601 duration such as "P3Y6M4DT12H30M5S", "PT0.5S" or "P2W".
+// Weeks are converted into days. The last component may have a decimal
+// fraction (written with either "." or ","), except for years and months;
+// fractions of days and weeks are added to the clock time. A leading "-"
+// negates the whole duration. The error is a ParseError wrapping
+// ErrBadDuration.
+func ParseDuration(s string) (d Duration, err error) {
+	i := 0
+	sign := 1
+
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		if s[i] == '-' {
+			sign = -1
+		}
+		i++
+	}
+
+	if i >= len(s) || (s[i] != 'P' && s[i] != 'p') {
+		return d, durationError(s, "Expected 'P'", i, len(s))
+	}
+	i++
+
+	inTime := false
+	last := -1
+	seenFraction := false
+	seenComponent := false
+
+	for i < len(s) {
+		if s[i] == 'T' || s[i] == 't' {
+			if inTime {
+				return d, durationError(s, "Repeated 'T'", i, i+1)
+			}
+			inTime = true
+			i++
+			if i >= len(s) {
+				return d, durationError(s, "Expected time components after 'T'", i-1, i)
+			}
+			continue
+		}
+
+		start := i
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == start {
+			return d, durationError(s, "Expected number", start, i+1)
+		}
+		if i >= len(s) {
+			return d, durationError(s, "Expected designator after number", start, i)
+		}
+
+		number := strings.Replace(s[start:i], ",", ".", 1)
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return d, durationError(s, "Could not parse number", start, i)
+		}
+
+		var component int
+		switch s[i] {
+		case 'Y', 'y':
+			component = _DURATION_YEAR
+		case 'M', 'm':
+			component = _DURATION_MONTH
+			if inTime {
+				component = _DURATION_MINUTE
+			}
+		case 'W', 'w':
+			component = _DURATION_WEEK
+		case 'D', 'd':
+			component = _DURATION_DAY
+		case 'H', 'h':
+			component = _DURATION_HOUR
+		case 'S', 's':
+			component = _DURATION_SECOND
+		default:
+			return d, durationError(s, "Unrecognised designator", i, i+1)
+		}
+
+		if (component >= _DURATION_HOUR) != inTime {
+			return d, durationError(s, "Designator in wrong part of duration", i, i+1)
+		}
+		if component <= last {
+			return d, durationError(s, "Component out of order or repeated", start, i+1)
+		}
+		if seenFraction {
+			return d, durationError(s, "Only the last component may have a fraction", start, i+1)
+		}
+		if component == _DURATION_WEEK {
+			value *= 7
+		}
+		if value >= math.MaxInt64 || (component <= _DURATION_DAY && float64(d.Days)+value >= math.MaxInt64) {
+			return d, durationError(s, "Duration out of range", start, i+1)
+		}
+
+		seenFraction = hasFractional(value)
+		seenComponent = true
+		last = component
+
+		switch component {
+		case _DURATION_YEAR, _DURATION_MONTH:
+			if seenFraction {
+				return d, durationError(s, "Years and months may not have a fraction", start, i+1)
+			}
+			if component == _DURATION_YEAR {
+				d.Years = int(value)
+			} else {
+				d.Months = int(value)
+			}
+
+		case _DURATION_WEEK, _DURATION_DAY:
+			d.Days += int(value)
+			d.Clock += time.Duration(math.Round(getFractional(value) * float64(24*time.Hour)))
+
+		default:
+			term := math.Round(value * float64(clockUnits[component]))
+			if term+float64(d.Clock) >= math.MaxInt64 {
+				return d, durationError(s, "Duration out of range", start, i+1)
+			}
+			d.Clock += time.Duration(term)
+		}
+
+		i++
+	}
+
+	if !seenComponent {
+		return d, durationError(s, "Duration has no components", 0, len(s))
+	}
+
+	if sign < 0 {
+		d = d.Neg()
+	}
+
+	return d, nil
+}
+
+// Returns the duration with every component negated.
+func (d Duration) Neg() Duration {
+	return Duration{-d.Years, -d.Months, -d.Days, -d.Clock}
+}
+
+// Returns t with the duration added to it. The years, months and days are
+// added first (using time.Time.AddDate), followed by the clock time.
+func (d Duration) AddTo(t time.Time) time.Time {
+	return t.AddDate(d.Years, d.Months, d.Days).Add(d.Clock)
+}
+
+// Returns the ISO 8601 representation of the duration, for example
+// "P1Y2M3DT4H5M6.5S". If every component is zero or negative, the result is
+// a negated positive duration such as "-P1D", unless a component is too large
+// to negate.
+func (d Duration) String() string {
+	negatable := d.Years != math.MinInt && d.Months != math.MinInt && d.Days != math.MinInt && d.Clock != math.MinInt64
+	if d.Years <= 0 && d.Months <= 0 && d.Days <= 0 && d.Clock <= 0 && d != (Duration{}) && negatable {
+		return "-" + d.Neg().String()
+	}
+
+	var b strings.Builder
+	b.WriteString("P")
+
+	if d.Years != 0 {
+		b.WriteString(strconv.Itoa(d.Years) + "Y")
+	}
+	if d.Months != 0 {
+		b.WriteString(strconv.Itoa(d.Months) + "M")
+	}
+	if d.Days != 0 {
+		b.WriteString(strconv.Itoa(d.Days) + "D")
+	}
+
+	if d.Clock != 0 || b.Len() == 1 {
+		b.WriteString("T")
+		clock := d.Clock
+
+		hours := clock / time.Hour
+		clock -= hours * time.Hour
+		minutes := clock / time.Minute
+		clock -= minutes * time.Minute
+
+		if hours != 0 {
+			b.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+		}
+		if minutes != 0 {
+			b.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+		}
+		if clock != 0 || (hours == 0 && minutes == 0) {
+			b.WriteString(strconv.FormatFloat(clock.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+
+	return b.String()
+}
+
+// The lengths of the units that have a fixed length.
+var unitDurations = map[int]time.Duration{
+	_UNIT_SECOND: time.Second,
+	_UNIT_MINUTE: time.Minute,
+	_UNIT_HOUR:   time.Hour,
+	_UNIT_DAY:    24 * time.Hour,
+	_UNIT_WEEK:   7 * 24 * time.Hour,
+}
+
+// Parses a duration written for humans, such as "1h30m", "2 hours 15
+// minutes", "90 sec", "1.5 hours" or "3 days 4h", using the unit names in the
+// parser's vocabulary. Each number must be followed by a unit, and the units
+// must be given from largest to smallest with none repeated. A sign may only
+// come before the first term, as in "-2 hours". Days are taken to be 24 hours
+// long; months and years are rejected as they have no fixed length. The error
+// is a ParseError wrapping ErrBadDuration, or an error returned by
+// bufio.Reader.ReadRune.
+func (parser *Parser) ParseHumanDuration(s string) (d time.Duration, err error) {
+	info := parser.info()
+	lex := newLexer(strings.NewReader(s))
+	tokens, spans, err := lex.lexAll()
+	if err != nil {
+		return 0, err
+	}
+
+	numTokens := len(tokens)
+	last := _UNIT_YEAR + 1
+	i := 0
+
+	// A sign before the first term applies to the whole duration, as in "-2
+	// hours".
+	for i < numTokens && tokens[i] == " " {
+		i++
+	}
+	sign := time.Duration(1)
+	if i < numTokens && (tokens[i] == "-" || tokens[i] == "+") {
+		if tokens[i] == "-" {
+			sign = -1
+		}
+		i++
+	}
+
+	for i < numTokens {
+		if tokens[i] == "-" || tokens[i] == "+" {
+			return 0, durationError(s, "Sign between terms", spans[i].start, spans[i].end)
+		}
+
+		if info.jump.search(tokens[i]) != _JUMP_NONE {
+			i++
+			continue
+		}
+
+		value, err := strconv.ParseFloat(tokens[i], 64)
+		if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+			return 0, durationError(s, "Expected number", spans[i].start, spans[i].end)
+		}
+
+		unit, next := unitAfter(info, tokens, i+1)
+		if unit == _UNIT_NONE {
+			return 0, durationError(s, "Expected unit after number", spans[i].start, spans[i].end)
+		}
+
+		unitSpan := spans[next-1]
+		length, ok := unitDurations[unit]
+		if !ok {
+			return 0, durationError(s, "Months and years have no fixed length", unitSpan.start, unitSpan.end)
+		}
+		if unit >= last {
+			return 0, durationError(s, "Unit repeated or out of order", unitSpan.start, unitSpan.end)
+		}
+
+		term := math.Round(value * float64(length))
+		if term+float64(d) >= math.MaxInt64 {
+			return 0, durationError(s, "Duration out of range", spans[i].start, unitSpan.end)
+		}
+
+		last = unit
+		d += time.Duration(term)
+		i = next
+	}
+
+	if last > _UNIT_YEAR {
+		return 0, durationError(s, "Duration has no components", 0, len(s))
+	}
+
+	return sign * d, nil
+}
+
+// Parses a duration written for humans using the default (English)
+// vocabulary. See Parser.ParseHumanDuration.
+func ParseHumanDuration(s string) (d time.Duration, err error) {
+	return defaultParser.ParseHumanDuration(s)
+}
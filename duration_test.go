@@ -0,0 +1,150 @@
+package dateparser
+
+import (
+    "errors"
+    "math"
+    "testing"
+    "time"
+)
+
+func checkDuration(t *testing.T, s string, expect Duration) {
+    d, err := ParseDuration(s)
+    if err != nil {
+        t.Errorf("Parse failure for %q: %s", s, err.Error())
+        return
+    }
+    
+    if d != expect {
+        t.Errorf("Expected %q to parse as %+v, got %+v", s, expect, d)
+    }
+}
+
+func TestISODuration(t *testing.T) {
+    checkDuration(t, "P3Y6M4DT12H30M5S", Duration{3, 6, 4, 12*time.Hour + 30*time.Minute + 5*time.Second})
+}
+
+func TestISODurationFractionalSeconds(t *testing.T) {
+    checkDuration(t, "PT0.5S", Duration{0, 0, 0, 500 * time.Millisecond})
+    checkDuration(t, "PT0,5S", Duration{0, 0, 0, 500 * time.Millisecond})
+}
+
+func TestISODurationWeeks(t *testing.T) {
+    checkDuration(t, "P2W", Duration{0, 0, 14, 0})
+}
+
+func TestISODurationFractionalDays(t *testing.T) {
+    checkDuration(t, "P1.5D", Duration{0, 0, 1, 12 * time.Hour})
+}
+
+func TestISODurationMonthsAndMinutes(t *testing.T) {
+    checkDuration(t, "P1MT1M", Duration{0, 1, 0, time.Minute})
+}
+
+func TestISODurationNegative(t *testing.T) {
+    checkDuration(t, "-P1DT2H", Duration{0, 0, -1, -2 * time.Hour})
+}
+
+func TestISODurationInvalid(t *testing.T) {
+    for _, s := range []string{"", "P", "PT", "3D", "P1.5Y", "P1D2Y", "P1H", "PT1D", "P1DT", "P1.5DT1H", "P1X", "P1D1D"} {
+        _, err := ParseDuration(s)
+        if !errors.Is(err, ErrBadDuration) {
+            t.Errorf("Expected %q to fail with ErrBadDuration, got %v", s, err)
+        }
+    }
+}
+
+func TestISODurationOutOfRange(t *testing.T) {
+    for _, s := range []string{"PT3000000H", "PT99999999999H", "-PT9223372036.854775808S", "PT2562047H60M", "P99999999999999999999Y", "P1317624576693539401W"} {
+        _, err := ParseDuration(s)
+        var perr ParseError
+        if !errors.As(err, &perr) || !errors.Is(err, ErrBadDuration) || perr.Why != "Duration out of range" {
+            t.Errorf("Expected %q to fail as out of range, got %v", s, err)
+        }
+    }
+}
+
+func TestISODurationStringExtreme(t *testing.T) {
+    d := Duration{Clock: math.MinInt64}
+    if s := d.String(); s != "PT-2562047H-47M-16.854775808S" {
+        t.Errorf("Unexpected format of the smallest clock time: %q", s)
+    }
+}
+
+func TestISODurationAddTo(t *testing.T) {
+    d, err := ParseDuration("P1M1DT1H")
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    start := time.Date(2003, 1, 31, 10, 0, 0, 0, UTCLoc)
+    expect := time.Date(2003, 3, 4, 11, 0, 0, 0, UTCLoc)
+    if res := d.AddTo(start); !res.Equal(expect) {
+        t.Errorf("Expected '%s', got '%s'", expect, res)
+    }
+}
+
+func TestISODurationString(t *testing.T) {
+    for _, s := range []string{"P3Y6M4DT12H30M5S", "PT0.5S", "P14D", "PT0S", "-P1DT2H"} {
+        d, err := ParseDuration(s)
+        if err != nil {
+            t.Errorf("Parse failure for %q: %s", s, err.Error())
+            continue
+        }
+        
+        if d.String() != s {
+            t.Errorf("Expected %q to format as itself, got %q", s, d.String())
+        }
+    }
+}
+
+func checkHumanDuration(t *testing.T, s string, expect time.Duration) {
+    d, err := ParseHumanDuration(s)
+    if err != nil {
+        t.Errorf("Parse failure for %q: %s", s, err.Error())
+        return
+    }
+    
+    if d != expect {
+        t.Errorf("Expected %q to parse as %s, got %s", s, expect, d)
+    }
+}
+
+func TestHumanDurationCompact(t *testing.T) {
+    checkHumanDuration(t, "1h30m", 90*time.Minute)
+    checkHumanDuration(t, "10h36m28.5s", 10*time.Hour+36*time.Minute+28500*time.Millisecond)
+}
+
+func TestHumanDurationWords(t *testing.T) {
+    checkHumanDuration(t, "2 hours 15 minutes", 2*time.Hour+15*time.Minute)
+    checkHumanDuration(t, "2 hours and 15 minutes", 2*time.Hour+15*time.Minute)
+    checkHumanDuration(t, "90 sec", 90*time.Second)
+    checkHumanDuration(t, "1.5 hours", 90*time.Minute)
+}
+
+func TestHumanDurationDaysAndWeeks(t *testing.T) {
+    checkHumanDuration(t, "3 days 4h", 76*time.Hour)
+    checkHumanDuration(t, "1 week, 2 days", 9*24*time.Hour)
+}
+
+func TestHumanDurationSign(t *testing.T) {
+    checkHumanDuration(t, "-2 hours", -2*time.Hour)
+    checkHumanDuration(t, "-1h30m", -90*time.Minute)
+    checkHumanDuration(t, "+90 sec", 90*time.Second)
+}
+
+func TestHumanDurationLocale(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(German)}
+    d, err := parser.ParseHumanDuration("2 Stunden 15 Minuten")
+    if err != nil || d != 2*time.Hour+15*time.Minute {
+        t.Errorf("Expected 2h15m, got %s (%v)", d, err)
+    }
+}
+
+func TestHumanDurationInvalid(t *testing.T) {
+    for _, s := range []string{"", "1h1h", "30m 1h", "1h30", "hours", "2 months", "1 year", "5 apples", "1h-30m", "1h -30m", "- -2h", "9999999999 days", "2562047h 60m"} {
+        _, err := ParseHumanDuration(s)
+        if !errors.Is(err, ErrBadDuration) {
+            t.Errorf("Expected %q to fail with ErrBadDuration, got %v", s, err)
+        }
+    }
+}
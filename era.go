@@ -0,0 +1,112 @@
+package dateparser
+
+import (
+	"unicode/utf8"
+)
+
+const (
+	_ERA_NONE int = -1 + iota
+
+	_ERA_BC
+	_ERA_AD
+)
+
+// Returns the result of looking up in table the word starting at tokens[i],
+// which may be written with full stops between the letters (as in "B.C." or
+// "O.S."), and the index of the token following it, or -1 if there is no such
+// word.
+func dottedWordAt(table *stnode, tokens []string, i int) (result int, next int) {
+	result = table.search(tokens[i])
+	if result != -1 {
+		return result, i + 1
+	}
+
+	numTokens := len(tokens)
+	result, next = -1, i
+	word := ""
+
+	for j := i; j < numTokens && len(word) < 5; j += 2 {
+		if utf8.RuneCountInString(tokens[j]) != 1 || !hasLetter(tokens[j]) {
+			break
+		}
+
+		word += tokens[j]
+		if r := table.search(word); r != -1 {
+			result, next = r, j+1
+		}
+
+		if j+1 >= numTokens || tokens[j+1] != "." {
+			break
+		}
+	}
+
+	return result, next
+}
+
+// Returns the era named by the tokens starting at tokens[i], which may be
+// written with full stops between the letters (as in "B.C."), and the index
+// of the token following it, or _ERA_NONE.
+func eraAt(info *ParserInfo, tokens []string, i int) (era int, next int) {
+	return dottedWordAt(info.era, tokens, i)
+}
+
+// Returns whether the tokens starting at tokens[i] name an era, either in info
+// or in one of parser.Calendars, so that the "A" of "A.D." is not mistaken for
+// "am".
+func (parser *Parser) isEraAt(info *ParserInfo, tokens []string, i int) (r bool) {
+	era, _ := eraAt(info, tokens, i)
+	cal, _ := parser.calendarEraAt(tokens, i)
+	return era != _ERA_NONE || cal != nil
+}
+
+// Returns whether tokens[i] is the sign of an ISO 8601 year at the start of
+// the input, as in "-0043-03-15", "+12345-01-01" or "-0043".
+func isSignedYearAt(tokens []string, i int) (r bool) {
+	return i == 0 && (tokens[i] == "-" || tokens[i] == "+") &&
+		i+1 < len(tokens) && len(tokens[i+1]) >= 4 && isDigits(tokens[i+1]) &&
+		(i+2 == len(tokens) || tokens[i+2] == "-")
+}
+
+// Assigns the year/month/day components of a date whose year is known to be
+// ymd[yearIndex], because it was written with an era or a sign. The other
+// components are a month and day, in that order if the year came first (as in
+// ISO 8601) or according to parser.DayFirst otherwise. Returns false if the
+// components cannot be assigned.
+func (parser *Parser) assignWithYear(res *parseresult, ymd []int, yearIndex int, monthNameIndex int) (ok bool) {
+	if yearIndex < 0 || yearIndex >= len(ymd) || yearIndex == monthNameIndex {
+		return false
+	}
+
+	res.Year = ymd[yearIndex]
+	rest := append(append([]int(nil), ymd[:yearIndex]...), ymd[yearIndex+1:]...)
+	if monthNameIndex > yearIndex {
+		monthNameIndex--
+	}
+
+	switch len(rest) {
+	case 0:
+
+	case 1:
+		if monthNameIndex == 0 {
+			res.Month = rest[0]
+		} else {
+			res.Day = rest[0]
+		}
+
+	case 2:
+		switch {
+		case monthNameIndex != -1:
+			res.Month = rest[monthNameIndex]
+			res.Day = rest[1-monthNameIndex]
+		case yearIndex == 0 || !parser.DayFirst:
+			res.Month, res.Day = rest[0], rest[1]
+		default:
+			res.Day, res.Month = rest[0], rest[1]
+		}
+
+	default:
+		return false
+	}
+
+	return true
+}
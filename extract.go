@@ -0,0 +1,353 @@
+package dateparser
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The maximum number of tokens (including whitespace and punctuation) in a
+// date found by Extract.
+const _EXTRACT_MAX_TOKENS = 40
+
+// A date or time found in a text by Parser.Extract.
+type Match struct {
+	Fragment // The text of the match and its position in the input.
+
+	Time   time.Time // The parsed date, as returned by Parse.
+	Result Result    // The components found in the match.
+}
+
+// Returns the vocabularies that the parser may use.
+func (parser *Parser) vocabularies() (infos []*ParserInfo) {
+	if len(parser.Languages) != 0 {
+		return parser.Languages
+	}
+
+	return []*ParserInfo{parser.info()}
+}
+
+// Returns whether the token is a jump word in any of the vocabularies.
+func isJump(infos []*ParserInfo, token string) (r bool) {
+	for _, info := range infos {
+		if info.jump.search(token) != _JUMP_NONE {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether the token is a word used in intervals (such as "to" or
+// "and") in any of the vocabularies. Such words separate two dates, so a
+// match never contains one.
+func isIntervalWord(infos []*ParserInfo, token string) (r bool) {
+	for _, info := range infos {
+		if info.interval.search(token) != _INTERVAL_NONE {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether the token is a month or weekday name in any of the
+// vocabularies.
+func isDateName(infos []*ParserInfo, token string) (r bool) {
+	for _, info := range infos {
+		if info.month.search(token) != _MONTH_NONE || info.weekday.search(token) != _WEEKDAY_NONE {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether s contains a letter.
+func hasLetter(s string) (r bool) {
+	for _, char := range s {
+		if unicode.IsLetter(char) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns, for each token, whether a match may not continue from the
+// previous token to it. Matches do not cross newlines, the punctuation marks
+// ";", "!" and "?", or a full stop followed by whitespace, unless the full stop
+// ends an abbreviated month or weekday name (as in "Sep. 25") or is followed
+// by one (as in the German "25. September").
+func sentenceBreaks(infos []*ParserInfo, text string, tokens []string, spans []span) (breaks []bool) {
+	numTokens := len(tokens)
+	breaks = make([]bool, numTokens)
+
+	for i := 1; i < numTokens; i++ {
+		prev := tokens[i-1]
+		gap := text[spans[i-1].end:spans[i].start]
+
+		switch {
+		case prev == ";" || prev == "!" || prev == "?":
+			breaks[i] = true
+
+		case strings.ContainsRune(text[spans[i-1].start:spans[i].start], '\n'):
+			breaks[i] = true
+
+		case prev == "." || strings.ContainsRune(gap, '.'):
+			before := i - 1
+			if prev == "." {
+				before--
+			}
+
+			after := i
+			for after < numTokens && tokens[after] == " " {
+				after++
+			}
+
+			if after == i && gap == "" {
+				continue
+			}
+			if before >= 0 && isDateName(infos, tokens[before]) {
+				continue
+			}
+			if after < numTokens && isDateName(infos, tokens[after]) {
+				continue
+			}
+
+			for b := i; b <= after && b < numTokens; b++ {
+				breaks[b] = true
+			}
+		}
+	}
+
+	return breaks
+}
+
+// Returns whether a run of tokens may start with the token: a short lowercase
+// word (such as "may", "sat" or "mar") is more likely to be an ordinary word
+// than a month or weekday name, unless it is a relative word such as "in".
+func canStartMatch(infos []*ParserInfo, token string) (r bool) {
+	if isJump(infos, token) || isIntervalWord(infos, token) || isPunctuation(token) {
+		return false
+	}
+
+	first, _ := utf8.DecodeRuneInString(token)
+	if !unicode.IsLetter(first) || unicode.IsUpper(first) || utf8.RuneCountInString(token) > 3 {
+		return true
+	}
+
+	for _, info := range infos {
+		if info.relative.search(token) != _RELATIVE_NONE {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether tokens are numbers joined by full stops that look more like
+// a version number (as in "1.2.3" or "2.10.1") than a date: none of the
+// numbers has four digits, and not all of them have two.
+func isVersionLike(tokens []string) (r bool) {
+	dotted, long, short := false, false, false
+	for _, token := range tokens {
+		switch {
+		case token == ".":
+			dotted = true
+		case !isDigits(token):
+			return false
+		case len(token) >= 4:
+			long = true
+		case len(token) != 2:
+			short = true
+		}
+	}
+
+	return dotted && !long && short
+}
+
+// Returns whether a run of tokens that parsed as pres is specific enough to be
+// reported as a date: it must have at least two components (as in
+// "2003-09-25" or "10:30"), or contain a word (as in "Thursday" or "3pm"). A
+// lone lowercase word of three letters or fewer (such as "may" or "sat") is
+// not enough, as it is more likely to be an ordinary word, and nor is a
+// version number such as "1.2.3".
+func qualifies(infos []*ParserInfo, tokens []string, pres parseresult) (r bool) {
+	if isVersionLike(tokens) {
+		return false
+	}
+
+	components := 0
+	for _, value := range []int{pres.Year, pres.Month, pres.Day, pres.Hour, pres.Minute, pres.Second, pres.Weekday, pres.Week, pres.Quarter, pres.Half} {
+		if value != -1 {
+			components++
+		}
+	}
+	if pres.TZName != "" || pres.HasTZOffset {
+		components++
+	}
+	if pres.HasRelative {
+		components++
+	}
+
+	if components == 0 {
+		return false
+	}
+	if components >= 2 {
+		return true
+	}
+
+	var words []string
+	numSignificant := 0
+	for _, token := range tokens {
+		if isJump(infos, token) {
+			continue
+		}
+		numSignificant++
+		if hasLetter(token) {
+			words = append(words, token)
+		}
+	}
+
+	if len(words) == 0 {
+		return false
+	}
+
+	if numSignificant == 1 {
+		word := words[0]
+		first, _ := utf8.DecodeRuneInString(word)
+		length := utf8.RuneCountInString(word)
+		return length > 3 || (length == 3 && unicode.IsUpper(first))
+	}
+
+	return true
+}
+
+// Returns whether next (the result of parsing a longer run of tokens) keeps
+// the time of day, weekday and timezone of prev. If it does not, the extra
+// tokens belong to a different date.
+func extendsResult(prev parseresult, next parseresult) (r bool) {
+	same := func(a int, b int) bool {
+		return a == -1 || a == b
+	}
+
+	return same(prev.Hour, next.Hour) &&
+		same(prev.Minute, next.Minute) &&
+		same(prev.Second, next.Second) &&
+		same(prev.Weekday, next.Weekday) &&
+		(prev.TZName == "" || prev.TZName == next.TZName) &&
+		(!prev.HasTZOffset || prev.TZOffset == next.TZOffset)
+}
+
+// Finds the longest run of tokens starting at tokens[i] that parses as a date,
+// without merging separate dates. Returns the match and the index of the token
+// following it, or -1 if there is no such run. The spans are byte offsets into
+// text.
+func (parser *Parser) matchAt(infos []*ParserInfo, def time.Time, text string, tokens []string, spans []span, breaks []bool, i int) (m Match, next int) {
+	numTokens := len(tokens)
+	next = -1
+
+	if !canStartMatch(infos, tokens[i]) {
+		return m, -1
+	}
+
+	var prev parseresult
+	limit := i + _EXTRACT_MAX_TOKENS
+	if limit > numTokens {
+		limit = numTokens
+	}
+
+	for j := i + 1; j <= limit; j++ {
+		if j > i+1 && breaks[j-1] {
+			break
+		}
+		if isIntervalWord(infos, tokens[j-1]) {
+			break
+		}
+
+		// Runs that end with a jump token parse the same as the shorter run.
+		if isJump(infos, tokens[j-1]) {
+			continue
+		}
+
+		pres, err := parser.parseLexed(text, append([]string(nil), tokens[i:j]...), spans[i:j])
+		if err != nil || !qualifies(infos, tokens[i:j], pres) {
+			continue
+		}
+		if next != -1 && !extendsResult(prev, pres) {
+			break
+		}
+
+		res := parser.newResult(text, pres)
+		if !res.validDate(def) {
+			continue
+		}
+
+		t, err := res.Time(def)
+		if err != nil {
+			continue
+		}
+
+		s, e := spans[i].start, spans[j-1].end
+		m = Match{Fragment{text[s:e], s, e}, t, res}
+		prev = pres
+		next = j
+	}
+
+	return m, next
+}
+
+// Finds every date or time mentioned in text, such as "Thursday at 10am",
+// "2003-09-25" or "in 3 days", and returns them in the order they appear along
+// with their positions and parsed values. Each match is the longest run of
+// text that parses on its own, but runs are not extended across sentences,
+// interval words such as "to", or into tokens that would change a time of
+// day, weekday or timezone already found; so "10am or 3pm" gives two matches.
+// Single numbers, and matches starting with short lowercase words such as
+// "may", are not reported.
+// Components not present in a match are taken from parser.Default as in
+// Parse. The parser's Fuzzy setting is ignored. The error is an error returned
+// by bufio.Reader.ReadRune.
+func (parser *Parser) Extract(text string) (matches []Match, err error) {
+	lex := newLexer(strings.NewReader(text))
+	tokens, spans, err := lex.lexAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.extractTokens(text, tokens, spans), nil
+}
+
+// Finds every date in a sequence of tokens whose spans are byte offsets into
+// text.
+func (parser *Parser) extractTokens(text string, tokens []string, spans []span) (matches []Match) {
+	strict := *parser
+	strict.Fuzzy = false
+
+	infos := strict.vocabularies()
+	def := strict.defaultTime()
+	breaks := sentenceBreaks(infos, text, tokens, spans)
+
+	i := 0
+	for i < len(tokens) {
+		m, next := strict.matchAt(infos, def, text, tokens, spans, breaks, i)
+		if next == -1 {
+			i++
+			continue
+		}
+
+		m.Result.parser = parser
+		matches = append(matches, m)
+		i = next
+	}
+
+	return matches
+}
+
+// Finds every date or time mentioned in text using a parser with all values at
+// their defaults. See Parser.Extract.
+func FindAll(text string) (matches []Match, err error) {
+	return defaultParser.Extract(text)
+}
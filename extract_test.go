@@ -0,0 +1,98 @@
+package dateparser
+
+import (
+    "testing"
+    "time"
+)
+
+func checkMatches(t *testing.T, parser *Parser, text string, expect []Match) {
+    matches, err := parser.Extract(text)
+    if err != nil {
+        t.Errorf("Extract failure for %q: %s", text, err.Error())
+        return
+    }
+    
+    if len(matches) != len(expect) {
+        t.Errorf("Expected %d matches in %q, got %d: %v", len(expect), text, len(matches), matches)
+        return
+    }
+    
+    for i, m := range matches {
+        if m.Fragment != expect[i].Fragment || !m.Time.Equal(expect[i].Time) {
+            t.Errorf("Expected match %d in %q to be %v at %s, got %v at %s", i, text, expect[i].Fragment, expect[i].Time, m.Fragment, m.Time)
+        }
+    }
+}
+
+func TestExtract(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "The report from 2003-09-25 is due Sep. 30, 2003, not later.", []Match{
+        {Fragment: Fragment{"2003-09-25", 16, 26}, Time: time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)},
+        {Fragment: Fragment{"Sep. 30, 2003", 34, 47}, Time: time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestExtractSeparateDates(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "Can we meet on Friday at 10am or 3pm?", []Match{
+        {Fragment: Fragment{"Friday at 10am", 15, 29}, Time: time.Date(2003, 9, 26, 10, 0, 0, 0, UTCLoc)},
+        {Fragment: Fragment{"3pm", 33, 36}, Time: time.Date(2003, 9, 25, 15, 0, 0, 0, UTCLoc)},
+    })
+    checkMatches(t, parser, "Sep 25 and Sep 26 are free", []Match{
+        {Fragment: Fragment{"Sep 25", 0, 6}, Time: time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)},
+        {Fragment: Fragment{"Sep 26", 11, 17}, Time: time.Date(2003, 9, 26, 0, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestExtractSentences(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "See you on Sep 26. 10 people are coming.", []Match{
+        {Fragment: Fragment{"Sep 26", 11, 17}, Time: time.Date(2003, 9, 26, 0, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestExtractRelative(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "Call me in 3 days, or tomorrow at 5pm.", []Match{
+        {Fragment: Fragment{"in 3 days", 8, 17}, Time: time.Date(2003, 9, 28, 0, 0, 0, 0, UTCLoc)},
+        {Fragment: Fragment{"tomorrow at 5pm", 22, 37}, Time: time.Date(2003, 9, 26, 17, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestExtractTimezone(t *testing.T) {
+    parser := &Parser{Default: TestDefault, TZInfos: TestTZInfos}
+    checkMatches(t, parser, "Moved to 10:30 BRST, sorry", []Match{
+        {Fragment: Fragment{"10:30 BRST", 9, 19}, Time: time.Date(2003, 9, 25, 10, 30, 0, 0, BRSTLoc)},
+    })
+}
+
+func TestExtractNoFalsePositives(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "You may sit at 42 tables, or sat on 7 chairs.", nil)
+    checkMatches(t, parser, "Call 555-1234 or 555-123-4567.", nil)
+    checkMatches(t, parser, "Upgrade from version 1.2.3 to 2.10.1.", nil)
+    checkMatches(t, parser, "Pi is 3.14 and the rate is 0.25.", nil)
+    checkMatches(t, parser, "Released on 1.2.2003.", []Match{
+        {Fragment: Fragment{"1.2.2003", 12, 20}, Time: time.Date(2003, 1, 2, 0, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestExtractTrailingSeparators(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkMatches(t, parser, "Meeting at 10:36 + snacks", []Match{
+        {Fragment: Fragment{"10:36", 11, 16}, Time: time.Date(2003, 9, 25, 10, 36, 0, 0, UTCLoc)},
+    })
+    checkMatches(t, parser, "Temperature was 10:36 -03: cold", []Match{
+        {Fragment: Fragment{"10:36 -03", 16, 25}, Time: time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -3*3600))},
+    })
+    checkMatches(t, parser, "Due Sep-25- or so", []Match{
+        {Fragment: Fragment{"Sep-25", 4, 10}, Time: time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)},
+    })
+}
+
+func TestFindAll(t *testing.T) {
+    matches, err := FindAll("Released on 2003-09-25 at 10:49:41.")
+    if err != nil || len(matches) != 1 || matches[0].Text != "2003-09-25 at 10:49:41" {
+        t.Errorf("Unexpected result from FindAll: %v, %v", matches, err)
+    }
+}
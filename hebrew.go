@@ -0,0 +1,167 @@
+package dateparser
+
+import (
+	"time"
+)
+
+// The Julian Day Number of the Hebrew epoch, from which the molads (new moons)
+// that determine the start of each year are counted.
+const _HEBREW_EPOCH_JDN = 347996
+
+// The Hebrew calendar. Months are numbered from Nisan (1), so that the year,
+// which begins on 1 Tishrei, runs from month 7 to month 12 (Adar, or Adar I in
+// a leap year) or 13 (Adar II), and then from month 1 to month 6 (Elul).
+type hebrew struct{}
+
+func (hebrew) FromGregorian(year int, month time.Month, day int) (y int, m int, d int) {
+	jdn := gregorianToJDN(year, month, day)
+
+	y = floorDiv((jdn-_HEBREW_EPOCH_JDN)*98496, 35975351) - 1
+	for jdn >= hebrewToJDN(y+1, 7, 1) {
+		y++
+	}
+
+	// Months 7 onwards come first in the year.
+	m = 1
+	if jdn < hebrewToJDN(y, 1, 1) {
+		m = 7
+	}
+	for jdn > hebrewToJDN(y, m, hebrewMonthDays(y, m)) {
+		m++
+	}
+	d = jdn - hebrewToJDN(y, m, 1) + 1
+
+	return y, m, d
+}
+
+func (hebrew) ToGregorian(year int, month int, day int) (y int, m time.Month, d int) {
+	// Adar II in a year without a leap month is the only Adar.
+	if month == 13 && !hebrewLeap(year) {
+		month = 12
+	}
+
+	return gregorianFromJDN(hebrewToJDN(year, month, day))
+}
+
+func (hebrew) String() string {
+	return "Hebrew"
+}
+
+// Returns whether a Hebrew year has a leap month (Adar II): 7 years in each
+// cycle of 19 do.
+func hebrewLeap(year int) (r bool) {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// Returns a % b with the sign of b.
+func floorMod(a int, b int) (r int) {
+	return a - b*floorDiv(a, b)
+}
+
+// Returns the number of days between the epoch and 1 Tishrei of a Hebrew
+// year, before the postponement that keeps the year from being too long.
+func hebrewElapsedDays(year int) (days int) {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days = months*29 + floorDiv(parts, 25920)
+
+	// 1 Tishrei may not fall on a Sunday, Wednesday or Friday.
+	if floorMod(3*(days+1), 7) < 3 {
+		days++
+	}
+
+	return days
+}
+
+// Returns the number of days by which 1 Tishrei of a Hebrew year is
+// postponed so that neither it nor the year before has an invalid length.
+func hebrewDelay(year int) (days int) {
+	last, present, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+
+	switch {
+	case next-present == 356:
+		return 2
+	case present-last == 382:
+		return 1
+	}
+
+	return 0
+}
+
+// Returns the number of days in a Hebrew year.
+func hebrewYearDays(year int) (days int) {
+	return hebrewToJDN(year+1, 7, 1) - hebrewToJDN(year, 7, 1)
+}
+
+// Returns the number of days in a month of a Hebrew year.
+func hebrewMonthDays(year int, month int) (days int) {
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeap(year):
+		return 29
+	case month == 8 && hebrewYearDays(year)%10 != 5:
+		// Heshvan has 30 days only in a complete year.
+		return 29
+	case month == 9 && hebrewYearDays(year)%10 == 3:
+		// Kislev has 29 days only in a deficient year.
+		return 29
+	}
+
+	return 30
+}
+
+// Returns the Julian Day Number of a date in the Hebrew calendar.
+func hebrewToJDN(year int, month int, day int) (jdn int) {
+	months := 12
+	if hebrewLeap(year) {
+		months = 13
+	}
+
+	jdn = _HEBREW_EPOCH_JDN + hebrewElapsedDays(year) + hebrewDelay(year) + day + 1
+	if month < 7 {
+		for m := 7; m <= months; m++ {
+			jdn += hebrewMonthDays(year, m)
+		}
+		for m := 1; m < month; m++ {
+			jdn += hebrewMonthDays(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			jdn += hebrewMonthDays(year, m)
+		}
+	}
+
+	return jdn
+}
+
+var (
+	// The arithmetical Hebrew calendar. Years are counted from the creation
+	// (Anno Mundi), as in "25 Elul 5763". Months are numbered from Nisan, so
+	// Tishrei is month 7 and Adar II is month 13.
+	Hebrew Calendar = hebrew{}
+
+	// The month names of the Hebrew calendar, in English transliteration. In
+	// a leap year "Adar" is Adar I.
+	HebrewVocabulary = CalendarVocabulary{
+		Calendar: Hebrew,
+		Months: [][]string{
+			{"nisan", "nissan"},
+			{"iyar", "iyyar"},
+			{"sivan"},
+			{"tammuz", "tamuz"},
+			{"av", "menachem av"},
+			{"elul"},
+			{"tishrei", "tishri"},
+			{"heshvan", "cheshvan", "marcheshvan", "marheshvan"},
+			{"kislev"},
+			{"tevet", "teves"},
+			{"shevat", "shvat"},
+			{"adar", "adar i", "adar aleph", "adar rishon"},
+			{"adar ii", "adar bet", "adar beit", "adar sheni", "veadar"},
+		},
+	}
+
+	// The lookup tables for HebrewVocabulary.
+	HebrewInfo = NewCalendarInfo(HebrewVocabulary)
+)
@@ -0,0 +1,70 @@
+package dateparser
+
+import (
+	"time"
+)
+
+// The Julian Day Number of 1 Muharram AH 1 in the tabular Islamic calendar
+// (16 July 622 Julian).
+const _HIJRI_EPOCH_JDN = 1948440
+
+type hijri struct{}
+
+func (hijri) FromGregorian(year int, month time.Month, day int) (y int, m int, d int) {
+	jdn := gregorianToJDN(year, month, day)
+
+	y = floorDiv(30*(jdn-_HIJRI_EPOCH_JDN)+10646, 10631)
+	m = floorDiv(2*(jdn-29-hijriToJDN(y, 1, 1))+58, 59) + 1
+	if m > 12 {
+		m = 12
+	}
+	d = jdn - hijriToJDN(y, m, 1) + 1
+
+	return y, m, d
+}
+
+func (hijri) ToGregorian(year int, month int, day int) (y int, m time.Month, d int) {
+	return gregorianFromJDN(hijriToJDN(year, month, day))
+}
+
+func (hijri) String() string {
+	return "Hijri"
+}
+
+// Returns the Julian Day Number of a date in the tabular Islamic calendar, in
+// which the months alternate between 30 and 29 days and 11 years in each cycle
+// of 30 have a leap day at the end of the year.
+func hijriToJDN(year int, month int, day int) (jdn int) {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30) + _HIJRI_EPOCH_JDN - 1
+}
+
+var (
+	// The tabular (arithmetical) Islamic calendar, with the civil epoch of
+	// 16 July 622 (Julian). Dates may differ by a day or two from calendars
+	// based on sighting the new moon, such as the Umm al-Qura calendar.
+	Hijri Calendar = hijri{}
+
+	// The month names and era of the Hijri calendar, in English
+	// transliteration.
+	HijriVocabulary = CalendarVocabulary{
+		Calendar: Hijri,
+		Months: [][]string{
+			{"muharram", "muharrem"},
+			{"safar"},
+			{"rabi al-awwal", "rabi al-awal", "rabi ul-awwal", "rabi i"},
+			{"rabi al-thani", "rabi al-akhir", "rabi ul-akhir", "rabi ii"},
+			{"jumada al-awwal", "jumada al-ula", "jumada ul-awwal", "jumada i"},
+			{"jumada al-thani", "jumada al-akhirah", "jumada ul-akhir", "jumada ii"},
+			{"rajab"},
+			{"shaban", "shaaban"},
+			{"ramadan", "ramadhan", "ramazan"},
+			{"shawwal", "shawal"},
+			{"dhu al-qadah", "dhu al-qaadah", "dhul-qadah", "dhu l-qadah", "zul qadah"},
+			{"dhu al-hijjah", "dhul-hijjah", "dhu l-hijjah", "zul hijjah"},
+		},
+		Eras: []string{"ah"},
+	}
+
+	// The lookup tables for HijriVocabulary.
+	HijriInfo = NewCalendarInfo(HijriVocabulary)
+)
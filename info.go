@@ -0,0 +1,303 @@
+package dateparser
+
+import (
+	"strings"
+)
+
+// The words recognised by a Parser. A ParserInfo is built from a Vocabulary
+// using NewParserInfo. Words are matched case-insensitively.
+type Vocabulary struct {
+	// The name of the language, for example "en".
+	Language string
+
+	// Tokens that carry no meaning on their own and are skipped, such as
+	// punctuation and words like "at" or "on".
+	Jump []string
+
+	// Names of the days of the week, indexed by time.Weekday.
+	Weekdays [7][]string
+
+	// Names of the months, from January to December.
+	Months [12][]string
+
+	// Names of the hour, minute and second units, as in "10h36m".
+	HMS [3][]string
+
+	// AM and PM markers.
+	AMPM [2][]string
+
+	// Names of timezones that are equivalent to UTC.
+	UTCZone []string
+
+	// Words that indicate that a year follows a month, as in "Sep of 2003".
+	Pertain []string
+
+	// Words that introduce an ISO week number, as in "2003-W39" or
+	// "week 39".
+	Week []string
+
+	// Words that introduce a quarter, a half or a fiscal year, as in "Q3",
+	// "H1" or "FY2004".
+	Quarter    []string
+	Half       []string
+	FiscalYear []string
+
+	// Names of the eras before and after the start of the Christian era, as
+	// in "44 BC" and "AD 800". They may also be written with full stops
+	// between the letters, as in "B.C.".
+	BC []string
+	AD []string
+
+	// Markers of dates written in the Julian (Old Style) or Gregorian (New
+	// Style) calendar, as in "25 Sep 1700 O.S.". They may also be written
+	// with full stops between the letters.
+	OldStyle []string
+	NewStyle []string
+
+	// Eras from which years are counted in place of the Christian era, as
+	// in "Heisei 15" and "平成15年". A year written with one of these is
+	// never expanded from two digits.
+	YearEras []YearEra
+
+	// Words for the first year of an era, written after its name, as in
+	// "平成元年".
+	FirstYear []string
+
+	// Words written after a number to mark it as a year, month or day, as
+	// in "2003年9月25日".
+	YearSuffix  []string
+	MonthSuffix []string
+	DaySuffix   []string
+
+	// Names of the units used in relative expressions, from seconds to
+	// years: second, minute, hour, day, week, month and year.
+	Units [7][]string
+
+	// Words used in relative expressions.
+	Yesterday []string // "yesterday"
+	Today     []string // "today"
+	Tomorrow  []string // "tomorrow"
+	Ago       []string // "3 days ago"
+	In        []string // "in 3 days"
+	From      []string // "3 days from now"
+	Now       []string // "3 days from now"
+	Next      []string // "next friday"
+	Last      []string // "last friday"
+
+	// Words used in intervals.
+	Between []string // "between 25 and 30 Sep"
+	Until   []string // "25 to 30 Sep"
+	And     []string // "between 25 and 30 Sep"
+}
+
+// The lookup tables used by a Parser, built from a Vocabulary. A single
+// ParserInfo may be shared between any number of Parsers.
+type ParserInfo struct {
+	Language string // The language of the vocabulary.
+
+	jump     *stnode
+	weekday  *stnode
+	month    *stnode
+	hms      *stnode
+	ampm     *stnode
+	utczone  *stnode
+	pertain  *stnode
+	week     *stnode
+	period   *stnode
+	era      *stnode
+	style    *stnode
+	yearEra  *stnode
+	suffix   *stnode
+	unit     *stnode
+	relative *stnode
+	interval *stnode
+
+	yearEraStarts  []int    // The first year of each era in yearEra.
+	firstYear      *stnode  // Words for the first year of an era.
+	firstYearWords []string // The same words, in lower case.
+}
+
+// The default (English) vocabulary. To extend it, copy it and assign new
+// slices to the fields being changed, as the slices are shared.
+var English = Vocabulary{
+	Language: "en",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"at", "on", "and", "m", "t", "of",
+		"st", "nd", "rd", "th",
+	},
+	Weekdays: [7][]string{
+		{"sun", "sunday"},
+		{"mon", "monday"},
+		{"tue", "tues", "tuesday"},
+		{"wed", "wednesday"},
+		{"thu", "thur", "thurs", "thursday"},
+		{"fri", "friday"},
+		{"sat", "saturday"},
+	},
+	Months: [12][]string{
+		{"jan", "january"},
+		{"feb", "february"},
+		{"mar", "march"},
+		{"apr", "april"},
+		{"may"},
+		{"jun", "june"},
+		{"jul", "july"},
+		{"aug", "august"},
+		{"sep", "sept", "september"},
+		{"oct", "october"},
+		{"nov", "november"},
+		{"dec", "december"},
+	},
+	HMS: [3][]string{
+		{"h", "hour", "hours"},
+		{"m", "min", "minute", "minutes"},
+		{"s", "sec", "second", "seconds"},
+	},
+	AMPM: [2][]string{
+		{"am", "a"},
+		{"pm", "p"},
+	},
+	UTCZone:    []string{"utc", "gmt", "z"},
+	Pertain:    []string{"of"},
+	Week:       []string{"w", "wk", "week", "cw"},
+	Quarter:    []string{"q"},
+	Half:       []string{"h"},
+	FiscalYear: []string{"fy"},
+	BC:         []string{"bc", "bce"},
+	AD:         []string{"ad", "ce"},
+	OldStyle:   []string{"os"},
+	NewStyle:   []string{"ns"},
+
+	YearEras:    EastAsianEras,
+	FirstYear:   []string{"元年", "gannen"},
+	YearSuffix:  []string{"年", "년"},
+	MonthSuffix: []string{"月", "월"},
+	DaySuffix:   []string{"日", "일"},
+	Units: [7][]string{
+		{"sec", "secs", "second", "seconds"},
+		{"min", "mins", "minute", "minutes"},
+		{"hr", "hrs", "hour", "hours"},
+		{"day", "days"},
+		{"wk", "wks", "week", "weeks"},
+		{"month", "months"},
+		{"yr", "yrs", "year", "years"},
+	},
+	Yesterday: []string{"yesterday"},
+	Today:     []string{"today"},
+	Tomorrow:  []string{"tomorrow"},
+	Ago:       []string{"ago"},
+	In:        []string{"in"},
+	From:      []string{"from"},
+	Now:       []string{"now"},
+	Next:      []string{"next"},
+	Last:      []string{"last"},
+	Between:   []string{"between"},
+	Until:     []string{"to", "until", "till", "through", "thru"},
+	And:       []string{"and"},
+}
+
+// The ParserInfo used by Parsers that do not specify one.
+var DefaultParserInfo = NewParserInfo(English)
+
+// Appends an stinput for each word, all with the same result.
+func appendWords(inputs []stinput, words []string, result int) []stinput {
+	for _, word := range words {
+		inputs = append(inputs, stinput{strings.ToLower(word), result})
+	}
+
+	return inputs
+}
+
+// Builds a table from a list of word lists, where the words in lists[i] map to
+// first + i.
+func buildIndexed(lists [][]string, first int) (root *stnode) {
+	var inputs []stinput
+	for i, words := range lists {
+		inputs = appendWords(inputs, words, first+i)
+	}
+
+	return stBuild(inputs)
+}
+
+// Builds the lookup tables for a vocabulary.
+func NewParserInfo(v Vocabulary) (info *ParserInfo) {
+	var relative []stinput
+	relative = appendWords(relative, v.Yesterday, _RELATIVE_YESTERDAY)
+	relative = appendWords(relative, v.Today, _RELATIVE_TODAY)
+	relative = appendWords(relative, v.Tomorrow, _RELATIVE_TOMORROW)
+	relative = appendWords(relative, v.Ago, _RELATIVE_AGO)
+	relative = appendWords(relative, v.In, _RELATIVE_IN)
+	relative = appendWords(relative, v.From, _RELATIVE_FROM)
+	relative = appendWords(relative, v.Now, _RELATIVE_NOW)
+	relative = appendWords(relative, v.Next, _RELATIVE_NEXT)
+	relative = appendWords(relative, v.Last, _RELATIVE_LAST)
+
+	var period []stinput
+	period = appendWords(period, v.Quarter, _PERIOD_QUARTER)
+	period = appendWords(period, v.Half, _PERIOD_HALF)
+	period = appendWords(period, v.FiscalYear, _PERIOD_FISCAL)
+
+	var era []stinput
+	era = appendWords(era, v.BC, _ERA_BC)
+	era = appendWords(era, v.AD, _ERA_AD)
+
+	var style []stinput
+	style = appendWords(style, v.OldStyle, _STYLE_OLD)
+	style = appendWords(style, v.NewStyle, _STYLE_NEW)
+
+	var yearEra []stinput
+	var yearEraStarts []int
+	for index, era := range v.YearEras {
+		yearEra = appendWords(yearEra, era.Names, index)
+		yearEraStarts = append(yearEraStarts, era.Start)
+	}
+
+	var firstYearWords []string
+	for _, word := range v.FirstYear {
+		firstYearWords = append(firstYearWords, strings.ToLower(word))
+	}
+
+	var suffix []stinput
+	suffix = appendWords(suffix, v.YearSuffix, _SUFFIX_YEAR)
+	suffix = appendWords(suffix, v.MonthSuffix, _SUFFIX_MONTH)
+	suffix = appendWords(suffix, v.DaySuffix, _SUFFIX_DAY)
+
+	var interval []stinput
+	interval = appendWords(interval, v.Between, _INTERVAL_BETWEEN)
+	interval = appendWords(interval, v.Until, _INTERVAL_UNTIL)
+	interval = appendWords(interval, v.And, _INTERVAL_AND)
+
+	return &ParserInfo{
+		Language: v.Language,
+		jump:     stBuild(appendWords(nil, v.Jump, _JUMP)),
+		weekday:  buildIndexed(v.Weekdays[:], _WEEKDAY_SUN),
+		month:    buildIndexed(v.Months[:], _MONTH_JAN),
+		hms:      buildIndexed(v.HMS[:], _HMS_HOUR),
+		ampm:     buildIndexed(v.AMPM[:], _AMPM_AM),
+		utczone:  stBuild(appendWords(nil, v.UTCZone, _UTCZONE)),
+		pertain:  stBuild(appendWords(nil, v.Pertain, _PERTAIN)),
+		week:     stBuild(appendWords(nil, v.Week, _WEEK)),
+		period:   stBuild(period),
+		era:      stBuild(era),
+		style:    stBuild(style),
+		yearEra:  stBuild(yearEra),
+		suffix:   stBuild(suffix),
+		unit:     buildIndexed(v.Units[:], _UNIT_SECOND),
+		relative: stBuild(relative),
+		interval: stBuild(interval),
+
+		yearEraStarts:  yearEraStarts,
+		firstYear:      stBuild(appendWords(nil, v.FirstYear, 0)),
+		firstYearWords: firstYearWords,
+	}
+}
+
+// Returns the ParserInfo used by the parser.
+func (parser *Parser) info() (info *ParserInfo) {
+	if parser.Info != nil {
+		return parser.Info
+	}
+
+	return DefaultParserInfo
+}
@@ -0,0 +1,350 @@
+package dateparser
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	_INTERVAL_NONE int = -1 + iota
+
+	_INTERVAL_BETWEEN
+	_INTERVAL_UNTIL
+	_INTERVAL_AND
+)
+
+// Returns the number of numbers joined to each other by the separator at
+// tokens[sep] (without spaces) immediately before and after it, between lo
+// and hi. In "2003-09-25" the first dash has one number before it and two
+// after it.
+func separatorChain(tokens []string, lo int, hi int, sep int) (before int, after int) {
+	for j := sep - 1; j >= lo && isDigits(tokens[j]); j -= 2 {
+		before++
+		if j-1 < lo || tokens[j-1] != tokens[sep] {
+			break
+		}
+	}
+
+	for j := sep + 1; j < hi && isDigits(tokens[j]); j += 2 {
+		after++
+		if j+1 >= hi || tokens[j+1] != tokens[sep] {
+			break
+		}
+	}
+
+	return before, after
+}
+
+// Returns the indices of the tokens between lo and hi at which the input could
+// be split into the start and end of an interval, most likely first: words
+// such as "to" (or "and" after "between"), dashes surrounded by spaces and
+// en or em dashes, and then slashes and other dashes. Of the last two, the one
+// that appears less often is tried first, as the other is more likely to
+// separate the components of the dates (as in "2003-09-25/2003-09-30" and
+// "09/25/2003-09/30/2003"). A slash or dash that joins the components of a
+// date, as in "2003-09-25" or "09/25/2003", is not a separator; it may only
+// join single numbers (as in "25-30 Sep") or two whole dates.
+func intervalSeparators(info *ParserInfo, tokens []string, lo int, hi int, between bool) (seps []int) {
+	spaced := func(i int) bool {
+		return i > lo && i+1 < hi && tokens[i-1] == " " && tokens[i+1] == " "
+	}
+
+	partOfDate := func(i int) bool {
+		before, after := separatorChain(tokens, lo, hi, i)
+		return (before > 1 || after > 1) && (before != 3 || after != 3)
+	}
+
+	var slashes, dashes []int
+
+	for i := lo; i < hi; i++ {
+		switch info.interval.search(tokens[i]) {
+		case _INTERVAL_UNTIL:
+			seps = append(seps, i)
+		case _INTERVAL_AND:
+			if between {
+				seps = append(seps, i)
+			}
+		}
+	}
+
+	for i := lo; i < hi; i++ {
+		switch {
+		case (tokens[i] == "-" && spaced(i)) || tokens[i] == "–" || tokens[i] == "—":
+			seps = append(seps, i)
+		case tokens[i] == "-" && !partOfDate(i):
+			dashes = append(dashes, i)
+		case tokens[i] == "/" && !partOfDate(i):
+			slashes = append(slashes, i)
+		}
+	}
+
+	if len(dashes) < len(slashes) {
+		return append(append(seps, dashes...), slashes...)
+	}
+
+	return append(append(seps, slashes...), dashes...)
+}
+
+// Returns whether s (with surrounding spaces removed) looks like an ISO 8601
+// duration, such as "PT2H".
+func isISODuration(s string) (r bool) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimLeft(s, "+-")
+
+	return len(s) > 1 && (s[0] == 'P' || s[0] == 'p') && (s[1] >= '0' && s[1] <= '9' || s[1] == 'T' || s[1] == 't')
+}
+
+// Returns whether the result contains a date (as opposed to only a time),
+// either as components or as a relative offset in days or more.
+func (res Result) hasDate() (r bool) {
+	return res.HasYear || res.HasMonth || res.HasDay || res.HasWeekday || res.HasPeriod() ||
+		res.RelYears != 0 || res.RelMonths != 0 || res.RelDays != 0
+}
+
+// Returns the result with the components that are written only once in an
+// interval taken from the other end of the interval. A missing timezone is
+// always taken from other. Missing date components are taken from other only
+// if they are larger than a date component in the result, so that in
+// "25-30 Sep 2003" the start gains the month and year (but "Sep 2003" does
+// not gain a day), and a start that is only a time of day gains the whole
+// date.
+func (res Result) filledFrom(other Result) (r Result) {
+	if !res.hasDate() {
+		res.Year, res.HasYear = other.Year, other.HasYear
+		res.Month, res.HasMonth = other.Month, other.HasMonth
+		res.Day, res.HasDay = other.Day, other.HasDay
+		res.Weekday, res.HasWeekday = other.Weekday, other.HasWeekday
+		res.WeekdayDirection = other.WeekdayDirection
+		res.RelYears, res.RelMonths, res.RelDays = other.RelYears, other.RelMonths, other.RelDays
+		res.HasRelative = res.HasRelative || other.HasRelative
+		res.Calendar = other.Calendar
+
+	} else {
+		if !res.HasMonth && res.HasDay {
+			res.Month, res.HasMonth = other.Month, other.HasMonth
+		}
+		if !res.HasYear && (res.HasMonth || res.HasDay || res.HasPeriod()) {
+			res.Year, res.HasYear = other.Year, other.HasYear
+		}
+	}
+
+	if res.TZName == "" && !res.HasTZOffset {
+		res.TZName, res.TZOffset, res.HasTZOffset = other.TZName, other.TZOffset, other.HasTZOffset
+		res.TZAmbiguous = other.TZAmbiguous
+		res.Zone, res.zoneCritical, res.zoneLabel, res.zoneSpan = other.Zone, other.zoneCritical, other.zoneLabel, other.zoneSpan
+		res.tzSpan = other.tzSpan
+	}
+
+	return res
+}
+
+// Parses one end of an interval, made up of the tokens from lo to hi. Exactly
+// one of res and dur is meaningful: dur is non-nil if the end is an ISO 8601
+// duration.
+func (parser *Parser) parseIntervalEnd(info *ParserInfo, timestr string, tokens []string, spans []span, lo int, hi int) (res Result, dur *Duration, err error) {
+	for lo < hi && tokens[lo] == " " {
+		lo++
+	}
+	for hi > lo && tokens[hi-1] == " " {
+		hi--
+	}
+
+	if lo == hi {
+		return res, nil, parser.errorAt(timestr, ErrBadInterval, "Missing start or end of interval", "<no-specific-location>", span{-1, -1})
+	}
+
+	sp := span{spans[lo].start, spans[hi-1].end}
+	text := timestr[sp.start:sp.end]
+
+	if isISODuration(text) {
+		d, err := ParseDuration(text)
+		if err != nil {
+			return res, nil, parser.errorAt(timestr, ErrBadDuration, "Bad duration in interval", text, sp)
+		}
+		return res, &d, nil
+	}
+
+	pres, err := parser.parseTokens(info, timestr, append([]string(nil), tokens[lo:hi]...), spans[lo:hi])
+	if err != nil {
+		return res, nil, err
+	}
+
+	// "30 2003" is read as a month and year on its own, but can only be a
+	// day and year when the month is written at the other end, as in
+	// "Sep 25-30 2003".
+	if pres.Month > 12 && pres.Day == -1 {
+		pres.Day, pres.Month = pres.Month, -1
+	}
+
+	if pres.Month == 0 || pres.Month > 12 || pres.Day == 0 || pres.Day > 31 {
+		return res, nil, parser.errorAt(timestr, ErrBadInterval, "Month or day out of range", text, sp)
+	}
+
+	return parser.newResult(timestr, pres), nil, nil
+}
+
+// Parses an interval whose start and end are separated by the token at sep.
+func (parser *Parser) parseIntervalAt(info *ParserInfo, timestr string, tokens []string, spans []span, lo int, sep int, hi int) (start time.Time, end time.Time, err error) {
+	left, leftDur, err := parser.parseIntervalEnd(info, timestr, tokens, spans, lo, sep)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	right, rightDur, err := parser.parseIntervalEnd(info, timestr, tokens, spans, sep+1, hi)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	def := parser.defaultTime()
+
+	switch {
+	case leftDur != nil && rightDur != nil:
+		return zeroTime, zeroTime, parser.errorAt(timestr, ErrBadInterval, "Both ends of interval are durations", tokens[sep], spans[sep])
+
+	case leftDur != nil:
+		end, err = right.Time(def)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+		start = leftDur.Neg().AddTo(end)
+
+	case rightDur != nil:
+		start, err = left.Time(def)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+		end = rightDur.AddTo(start)
+
+	default:
+		start, err = left.filledFrom(right).Time(def)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+
+		// A weekday at the end (as in "Mon - Fri") is the first one on or
+		// after the start, rather than after the default date.
+		endDef := def
+		if right.HasWeekday && !right.HasDay && !right.HasWeek {
+			endDef = time.Date(start.Year(), start.Month(), start.Day(), def.Hour(), def.Minute(), def.Second(), def.Nanosecond(), def.Location())
+		}
+
+		end, err = right.filledFrom(left).Time(endDef)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+
+		// A start that takes its year from the end and falls in a later
+		// month (as in "Dec 30 - Jan 2 2004") is in the year before.
+		if end.Before(start) && !left.HasYear && right.HasYear && start.Month() > end.Month() {
+			start = start.AddDate(-1, 0, 0)
+		}
+
+		// An end that is only a time of day and falls before the start
+		// (as in "10pm-2am") is taken to be on the following day.
+		if end.Before(start) && !right.hasDate() {
+			end = end.AddDate(0, 0, 1)
+		}
+
+		// An end that is a period (as in "Q1-Q3 2003") includes the whole
+		// period.
+		if right.HasPeriod() {
+			end = end.AddDate(0, right.periodLength(), 0)
+		}
+	}
+
+	if end.Before(start) {
+		return zeroTime, zeroTime, parser.errorAt(timestr, ErrBadInterval, "End of interval is before its start", timestr[spans[sep].end:], span{spans[sep].end, len(timestr)})
+	}
+
+	return start, end, nil
+}
+
+// Parses an interval using the vocabulary in info.
+func (parser *Parser) parseIntervalTokens(info *ParserInfo, timestr string, tokens []string, spans []span) (start time.Time, end time.Time, err error) {
+	lo, hi := 0, len(tokens)
+
+	for lo < hi && info.jump.search(tokens[lo]) != _JUMP_NONE {
+		lo++
+	}
+
+	between := false
+	if lo < hi {
+		if info.interval.search(tokens[lo]) == _INTERVAL_BETWEEN {
+			between = true
+			lo++
+		} else if info.relative.search(tokens[lo]) == _RELATIVE_FROM {
+			lo++
+		}
+	}
+
+	// A single period such as "Q3 2003" or "2003-Q3" is the interval it
+	// covers.
+	pres, err := parser.parseTokens(info, timestr, append([]string(nil), tokens[lo:hi]...), spans[lo:hi])
+	if err == nil {
+		res := parser.newResult(timestr, pres)
+		if res.HasPeriod() {
+			return res.Period(parser.defaultTime())
+		}
+	}
+
+	seps := intervalSeparators(info, tokens, lo, hi, between)
+	if len(seps) == 0 {
+		return zeroTime, zeroTime, parser.errorAt(timestr, ErrBadInterval, "No separator between start and end of interval", "<no-specific-location>", span{-1, -1})
+	}
+
+	var firstErr error
+	for _, sep := range seps {
+		start, end, err = parser.parseIntervalAt(info, timestr, tokens, spans, lo, sep, hi)
+		if err == nil {
+			return start, end, nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return zeroTime, zeroTime, firstErr
+}
+
+// Parses an interval such as "Sep 25 - Sep 30 2003" or
+// "2003-09-25T10:00/PT2H", and returns its start and end. Components written
+// only once apply to both ends, so "25-30 Sep 2003" is the 25th to the 30th of
+// September. The error may be a ParseError wrapping ErrBadInterval or an error
+// returned by bufio.Reader.ReadRune.
+func (parser *Parser) ParseInterval(timestr string) (start time.Time, end time.Time, err error) {
+	lex := newLexer(strings.NewReader(timestr))
+	tokens, spans, err := lex.lexAll()
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	if len(parser.Languages) == 0 {
+		return parser.parseIntervalTokens(parser.info(), timestr, tokens, spans)
+	}
+
+	candidates, err := parser.detectLanguages(timestr, tokens, spans)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	var firstErr error
+	for _, info := range candidates {
+		start, end, err = parser.parseIntervalTokens(info, timestr, tokens, spans)
+		if err == nil {
+			return start, end, nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return zeroTime, zeroTime, firstErr
+}
+
+// Parses an interval using a parser with all values at their defaults. See
+// Parser.ParseInterval.
+func ParseInterval(timestr string) (start time.Time, end time.Time, err error) {
+	return defaultParser.ParseInterval(timestr)
+}
@@ -0,0 +1,161 @@
+package dateparser
+
+import (
+    "errors"
+    "testing"
+    "time"
+)
+
+func checkInterval(t *testing.T, parser *Parser, timestr string, expectStart time.Time, expectEnd time.Time) {
+    start, end, err := parser.ParseInterval(timestr)
+    if err != nil {
+        t.Errorf("Parse failure for %q: %s", timestr, err.Error())
+        return
+    }
+    
+    if !start.Equal(expectStart) || !end.Equal(expectEnd) {
+        t.Errorf("Expected %q to parse as %s - %s, got %s - %s", timestr, expectStart, expectEnd, start, end)
+    }
+}
+
+func TestIntervalSpacedDash(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "Sep 25 - Sep 30 2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalSharedMonthAndYear(t *testing.T) {
+    parser := &Parser{Default: time.Date(2010, 1, 1, 0, 0, 0, 0, UTCLoc)}
+    checkInterval(t, parser, "25-30 Sep 2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "Sep 25–30 2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalTimes(t *testing.T) {
+    parser := &Parser{Default: time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc)}
+    checkInterval(t, parser, "10:00-12:30 on Thursday",
+        time.Date(2003, 9, 25, 10, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 25, 12, 30, 0, 0, UTCLoc))
+}
+
+func TestIntervalWeekdays(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "Mon - Fri",
+        time.Date(2003, 9, 29, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 10, 3, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "Fri - Mon",
+        time.Date(2003, 9, 26, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 29, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalYearRollover(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "Dec 30 - Jan 2 2004",
+        time.Date(2003, 12, 30, 0, 0, 0, 0, UTCLoc),
+        time.Date(2004, 1, 2, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "28 Dec to 3 Jan 2004",
+        time.Date(2003, 12, 28, 0, 0, 0, 0, UTCLoc),
+        time.Date(2004, 1, 3, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalOvernight(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "10pm-2am",
+        time.Date(2003, 9, 25, 22, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 26, 2, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalWords(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "from 10:00 to 12:30 on Sep 26",
+        time.Date(2003, 9, 26, 10, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 26, 12, 30, 0, 0, UTCLoc))
+    checkInterval(t, parser, "between 25 and 30 Sep 2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalISO(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "2003-09-25/2003-09-30",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "2003-09-25T10:00/PT2H",
+        time.Date(2003, 9, 25, 10, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 25, 12, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "P1D/2003-09-30",
+        time.Date(2003, 9, 29, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalSlashedDates(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    checkInterval(t, parser, "09/25/2003-09/30/2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "2003-09-25-2003-09-30",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalSingleDate(t *testing.T) {
+    parser := &Parser{Default: time.Date(2003, 1, 1, 0, 0, 0, 0, UTCLoc)}
+    for _, timestr := range []string{"2003-09-25", "09/25/2003", "2003-09-25 10:36"} {
+        _, _, err := parser.ParseInterval(timestr)
+        if !errors.Is(err, ErrBadInterval) {
+            t.Errorf("Expected %q to fail with ErrBadInterval, got %v", timestr, err)
+        }
+    }
+
+    _, _, err := parser.ParseInterval("10:00 - 12:30 -")
+    if _, ok := err.(ParseError); !ok {
+        t.Errorf("Expected a trailing dash to fail with a ParseError, got %v", err)
+    }
+}
+
+func TestIntervalSharedTimezone(t *testing.T) {
+    parser := &Parser{Default: TestDefault, TZInfos: TestTZInfos}
+    checkInterval(t, parser, "10:00 - 11:00 BRST",
+        time.Date(2003, 9, 25, 10, 0, 0, 0, BRSTLoc),
+        time.Date(2003, 9, 25, 11, 0, 0, 0, BRSTLoc))
+}
+
+func TestIntervalLocale(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Info: NewParserInfo(German)}
+    checkInterval(t, parser, "von 25. bis 30. September 2003",
+        time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 9, 30, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalPeriod(t *testing.T) {
+    parser := &Parser{Default: TestDefault, FiscalYearStart: time.October}
+    checkInterval(t, parser, "Q3 2003",
+        time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "2003-Q3",
+        time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "H1 2003",
+        time.Date(2003, 1, 1, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "FY2004",
+        time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc),
+        time.Date(2004, 10, 1, 0, 0, 0, 0, UTCLoc))
+    checkInterval(t, parser, "Q1 - Q3 2003",
+        time.Date(2003, 1, 1, 0, 0, 0, 0, UTCLoc),
+        time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestIntervalInvalid(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    for _, timestr := range []string{"Sep 25 2003", "Sep 30 - Sep 25 2003", "PT1H/PT2H"} {
+        _, _, err := parser.ParseInterval(timestr)
+        if !errors.Is(err, ErrBadInterval) {
+            t.Errorf("Expected %q to fail with ErrBadInterval, got %v", timestr, err)
+        }
+    }
+}
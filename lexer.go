@@ -14,11 +14,44 @@ const (
 	_ST_DIGIT_PERIOD
 )
 
+// A range of bytes in the input, from start (inclusive) to end (exclusive).
+type span struct {
+	start int
+	end   int
+}
+
+// Returns the part of sp from byte from to byte to, relative to its start.
+func (sp span) sub(from int, to int) (r span) {
+	r = span{sp.start + from, sp.start + to}
+	if r.end > sp.end {
+		r.end = sp.end
+	}
+	if r.start > r.end {
+		r.start = r.end
+	}
+
+	return r
+}
+
+// A character that has been read from the stream, along with its encoded size
+// in bytes.
+type lexChar struct {
+	char rune
+	size int
+}
+
+// A token waiting to be returned, along with its position in the input.
+type lexToken struct {
+	token []rune
+	sp    span
+}
+
 type lexer struct {
 	stream     *bufio.Reader
-	charStack  []rune
-	tokenStack [][]rune
+	charStack  []lexChar
+	tokenStack []lexToken
 	eof        bool
+	pos        int // The byte offset of the next character to be read.
 }
 
 func newLexer(stream io.Reader) (lex *lexer) {
@@ -27,32 +60,38 @@ func newLexer(stream io.Reader) (lex *lexer) {
 		charStack:  nil,
 		tokenStack: nil,
 		eof:        false,
+		pos:        0,
 	}
 }
 
-func (lex *lexer) lex() (tokenStr string, err error) {
+// Returns the next token and its position in the input. An empty token is
+// returned at the end of the input.
+func (lex *lexer) lex() (tokenStr string, sp span, err error) {
 	var token []rune
 	
 	if len(lex.tokenStack) > 0 {
-		token = lex.tokenStack[0]
+		next := lex.tokenStack[0]
 		lex.tokenStack = lex.tokenStack[1:]
-		return string(encode(token)), nil
+		return string(encode(next.token)), next.sp, nil
 	}
 
 	state := _ST_NONE
 	seenLetters := false
+	sp = span{lex.pos, lex.pos}
 
 loop:
 	for !lex.eof {
 		var nextChar rune = '\x00'
+		var nextSize int
 
 		if len(lex.charStack) > 0 {
-			nextChar = lex.charStack[0]
+			nextChar = lex.charStack[0].char
+			nextSize = lex.charStack[0].size
 			lex.charStack = lex.charStack[1:]
 
 		} else {
 			for nextChar == '\x00' {
-				nextChar, _, err = lex.stream.ReadRune()
+				nextChar, nextSize, err = lex.stream.ReadRune()
 
 				if err == io.EOF {
 					lex.eof = true
@@ -60,14 +99,21 @@ loop:
 				}
 
 				if err != nil {
-					return "", err
+					return "", sp, err
+				}
+
+				if nextChar == '\x00' {
+					lex.pos += nextSize
 				}
 			}
 		}
 
+		lex.pos += nextSize
+
 		switch state {
 		case _ST_NONE:
 			token = []rune{nextChar}
+			sp.start = lex.pos - nextSize
 
 			switch {
 			case unicode.IsLetter(nextChar):
@@ -91,7 +137,7 @@ loop:
 			case unicode.IsLetter(nextChar):
 				token = append(token, nextChar)
 			default:
-				lex.charStack = append(lex.charStack, nextChar)
+				lex.pushChar(nextChar, nextSize)
 				break loop
 			}
 
@@ -103,7 +149,7 @@ loop:
 			case unicode.IsDigit(nextChar):
 				token = append(token, nextChar)
 			default:
-				lex.charStack = append(lex.charStack, nextChar)
+				lex.pushChar(nextChar, nextSize)
 				break loop
 			}
 
@@ -117,7 +163,7 @@ loop:
 			case nextChar == '.' || unicode.IsLetter(nextChar):
 				token = append(token, nextChar)
 			default:
-				lex.charStack = append(lex.charStack, nextChar)
+				lex.pushChar(nextChar, nextSize)
 				break loop
 			}
 
@@ -129,28 +175,35 @@ loop:
 			case nextChar == '.' || unicode.IsDigit(nextChar):
 				token = append(token, nextChar)
 			default:
-				lex.charStack = append(lex.charStack, nextChar)
+				lex.pushChar(nextChar, nextSize)
 				break loop
 			}
 		}
 	}
 
+	sp.end = lex.pos
+
 	if (state == _ST_LETTER_PERIOD || state == _ST_DIGIT_PERIOD) &&
 			(seenLetters || has2Periods(token) || token[len(token)-1] == '.') {
 
 		lastIndex := 0
 		var newToken []rune
 		
+		newSpan := sp
+		
 		for i, char := range token {
 			if char == '.' {
 				part := token[lastIndex:i]
+				partStart := sp.start + size(token[:lastIndex])
+				partSpan := span{partStart, partStart + size(part)}
 				
 				if lastIndex == 0 {
 					newToken = part
+					newSpan = partSpan
 				} else {
-					lex.tokenStack = append(lex.tokenStack, []rune{'.'})
+					lex.tokenStack = append(lex.tokenStack, lexToken{[]rune{'.'}, span{partStart - 1, partStart}})
 					if len(part) > 0 {
-						lex.tokenStack = append(lex.tokenStack, part)
+						lex.tokenStack = append(lex.tokenStack, lexToken{part, partSpan})
 					}
 				}
 				
@@ -159,21 +212,30 @@ loop:
 		}
 		
 		if lastIndex < len(token) {
-			lex.tokenStack = append(lex.tokenStack, []rune{'.'})
-			lex.tokenStack = append(lex.tokenStack, token[lastIndex:])
+			partStart := sp.start + size(token[:lastIndex])
+			lex.tokenStack = append(lex.tokenStack, lexToken{[]rune{'.'}, span{partStart - 1, partStart}})
+			lex.tokenStack = append(lex.tokenStack, lexToken{token[lastIndex:], span{partStart, sp.end}})
 		}
 		
 		token = newToken
+		sp = newSpan
 	}
 	
-	return string(encode(token)), nil
+	return string(encode(token)), sp, nil
+}
+
+// Pushes a character back so that it is returned by the next read.
+func (lex *lexer) pushChar(char rune, size int) {
+	lex.charStack = append(lex.charStack, lexChar{char, size})
+	lex.pos -= size
 }
 
-func (lex *lexer) lexAll() (tokens []string, err error) {
+// Returns all remaining tokens along with their positions in the input.
+func (lex *lexer) lexAll() (tokens []string, spans []span, err error) {
 	for {
-		token, err := lex.lex()
+		token, sp, err := lex.lex()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		
 		if token == "" {
@@ -181,7 +243,8 @@ func (lex *lexer) lexAll() (tokens []string, err error) {
 		}
 		
 		tokens = append(tokens, token)
+		spans = append(spans, sp)
 	}
 	
-	return tokens, nil
+	return tokens, spans, nil
 }
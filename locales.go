@@ -0,0 +1,369 @@
+package dateparser
+
+// Vocabularies for languages other than English. Use one with a Parser by
+// building a ParserInfo from it:
+//
+//	parser := &Parser{Info: NewParserInfo(French)}
+//
+// As with English, copy a vocabulary and assign new slices to the fields being
+// changed in order to extend it.
+
+// The French vocabulary.
+var French = Vocabulary{
+	Language: "fr",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "le", "la", "l", "à", "a", "de", "du", "et", "vers", "er", "e",
+	},
+	Weekdays: [7][]string{
+		{"dim", "dimanche"},
+		{"lun", "lundi"},
+		{"mar", "mardi"},
+		{"mer", "mercredi"},
+		{"jeu", "jeudi"},
+		{"ven", "vendredi"},
+		{"sam", "samedi"},
+	},
+	Months: [12][]string{
+		{"janv", "janvier"},
+		{"févr", "fevr", "février", "fevrier"},
+		{"mars"},
+		{"avr", "avril"},
+		{"mai"},
+		{"juin"},
+		{"juil", "juillet"},
+		{"août", "aout"},
+		{"sept", "septembre"},
+		{"oct", "octobre"},
+		{"nov", "novembre"},
+		{"déc", "dec", "décembre", "decembre"},
+	},
+	HMS: [3][]string{
+		{"h", "heure", "heures"},
+		{"m", "min", "minute", "minutes"},
+		{"s", "sec", "seconde", "secondes"},
+	},
+	AMPM: [2][]string{
+		{"am"},
+		{"pm"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "sem", "semaine"},
+	Units: [7][]string{
+		{"sec", "seconde", "secondes"},
+		{"min", "minute", "minutes"},
+		{"heure", "heures"},
+		{"jour", "jours"},
+		{"semaine", "semaines"},
+		{"mois"},
+		{"an", "ans", "année", "années", "annee", "annees"},
+	},
+	Yesterday: []string{"hier"},
+	Tomorrow:  []string{"demain"},
+	In:        []string{"dans"},
+	Between:   []string{"entre"},
+	Until:     []string{"au"},
+	And:       []string{"et"},
+}
+
+// The German vocabulary.
+var German = Vocabulary{
+	Language: "de",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "am", "um", "im", "den", "der", "des", "dem", "vom", "und", "uhr",
+	},
+	Weekdays: [7][]string{
+		{"so", "sonntag"},
+		{"mo", "montag"},
+		{"di", "dienstag"},
+		{"mi", "mittwoch"},
+		{"do", "donnerstag"},
+		{"fr", "freitag"},
+		{"sa", "samstag", "sonnabend"},
+	},
+	Months: [12][]string{
+		{"jan", "januar", "jän", "jänner"},
+		{"feb", "februar", "feber"},
+		{"mär", "märz", "maerz", "mrz"},
+		{"apr", "april"},
+		{"mai"},
+		{"jun", "juni"},
+		{"jul", "juli"},
+		{"aug", "august"},
+		{"sep", "sept", "september"},
+		{"okt", "oktober"},
+		{"nov", "november"},
+		{"dez", "dezember"},
+	},
+	HMS: [3][]string{
+		{"h", "std", "stunde", "stunden"},
+		{"m", "min", "minute", "minuten"},
+		{"s", "sek", "sekunde", "sekunden"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "kw", "woche"},
+	Units: [7][]string{
+		{"sek", "sekunde", "sekunden"},
+		{"min", "minute", "minuten"},
+		{"std", "stunde", "stunden"},
+		{"tag", "tage", "tagen"},
+		{"woche", "wochen"},
+		{"monat", "monate", "monaten"},
+		{"jahr", "jahre", "jahren"},
+	},
+	Yesterday: []string{"gestern"},
+	Today:     []string{"heute"},
+	Tomorrow:  []string{"morgen"},
+	In:        []string{"in"},
+	Next:      []string{"nächsten", "nächster", "nächste", "kommenden"},
+	Last:      []string{"letzten", "letzter", "letzte", "vergangenen"},
+	From:      []string{"von"},
+	Between:   []string{"zwischen"},
+	Until:     []string{"bis"},
+	And:       []string{"und"},
+}
+
+// The Spanish vocabulary. "mar" is taken to mean March (marzo) rather than
+// Tuesday (martes).
+var Spanish = Vocabulary{
+	Language: "es",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "m", "de", "del", "el", "la", "las", "a", "y", "en", "º",
+	},
+	Weekdays: [7][]string{
+		{"dom", "domingo"},
+		{"lun", "lunes"},
+		{"martes"},
+		{"mié", "mie", "miércoles", "miercoles"},
+		{"jue", "jueves"},
+		{"vie", "viernes"},
+		{"sáb", "sab", "sábado", "sabado"},
+	},
+	Months: [12][]string{
+		{"ene", "enero"},
+		{"feb", "febrero"},
+		{"mar", "marzo"},
+		{"abr", "abril"},
+		{"may", "mayo"},
+		{"jun", "junio"},
+		{"jul", "julio"},
+		{"ago", "agosto"},
+		{"sep", "sept", "septiembre", "set", "setiembre"},
+		{"oct", "octubre"},
+		{"nov", "noviembre"},
+		{"dic", "diciembre"},
+	},
+	HMS: [3][]string{
+		{"h", "hora", "horas"},
+		{"min", "minuto", "minutos"},
+		{"s", "seg", "segundo", "segundos"},
+	},
+	AMPM: [2][]string{
+		{"am", "a"},
+		{"pm", "p"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "sem", "semana"},
+	Units: [7][]string{
+		{"seg", "segundo", "segundos"},
+		{"min", "minuto", "minutos"},
+		{"hora", "horas"},
+		{"día", "días", "dia", "dias"},
+		{"semana", "semanas"},
+		{"mes", "meses"},
+		{"año", "años", "ano", "anos"},
+	},
+	Yesterday: []string{"ayer"},
+	Today:     []string{"hoy"},
+	Tomorrow:  []string{"mañana", "manana"},
+	In:        []string{"en"},
+	Next:      []string{"próximo", "próxima", "proximo", "proxima"},
+	From:      []string{"desde"},
+	Between:   []string{"entre"},
+	Until:     []string{"al", "hasta"},
+	And:       []string{"y"},
+}
+
+// The Italian vocabulary. "mar" is taken to mean March (marzo) rather than
+// Tuesday (martedì).
+var Italian = Vocabulary{
+	Language: "it",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "di", "del", "il", "l", "lo", "la", "alle", "ore", "e", "a", "º",
+	},
+	Weekdays: [7][]string{
+		{"dom", "domenica"},
+		{"lun", "lunedì", "lunedi"},
+		{"martedì", "martedi"},
+		{"mer", "mercoledì", "mercoledi"},
+		{"gio", "giovedì", "giovedi"},
+		{"ven", "venerdì", "venerdi"},
+		{"sab", "sabato"},
+	},
+	Months: [12][]string{
+		{"gen", "gennaio"},
+		{"feb", "febbraio"},
+		{"mar", "marzo"},
+		{"apr", "aprile"},
+		{"mag", "maggio"},
+		{"giu", "giugno"},
+		{"lug", "luglio"},
+		{"ago", "agosto"},
+		{"set", "sett", "settembre"},
+		{"ott", "ottobre"},
+		{"nov", "novembre"},
+		{"dic", "dicembre"},
+	},
+	HMS: [3][]string{
+		{"h", "ora", "ore"},
+		{"m", "min", "minuto", "minuti"},
+		{"s", "sec", "secondo", "secondi"},
+	},
+	AMPM: [2][]string{
+		{"am"},
+		{"pm"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "settimana"},
+	Units: [7][]string{
+		{"sec", "secondo", "secondi"},
+		{"min", "minuto", "minuti"},
+		{"ora", "ore"},
+		{"giorno", "giorni"},
+		{"settimana", "settimane"},
+		{"mese", "mesi"},
+		{"anno", "anni"},
+	},
+	Yesterday: []string{"ieri"},
+	Today:     []string{"oggi"},
+	Tomorrow:  []string{"domani"},
+	Ago:       []string{"fa"},
+	In:        []string{"tra", "fra"},
+	Next:      []string{"prossimo", "prossima"},
+	Until:     []string{"al", "fino"},
+}
+
+// The Portuguese vocabulary.
+var Portuguese = Vocabulary{
+	Language: "pt",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "m", "de", "do", "da", "às", "as", "o", "a", "e", "em", "feira", "º",
+	},
+	Weekdays: [7][]string{
+		{"dom", "domingo"},
+		{"seg", "segunda"},
+		{"ter", "terça", "terca"},
+		{"qua", "quarta"},
+		{"qui", "quinta"},
+		{"sex", "sexta"},
+		{"sáb", "sab", "sábado", "sabado"},
+	},
+	Months: [12][]string{
+		{"jan", "janeiro"},
+		{"fev", "fevereiro"},
+		{"mar", "março", "marco"},
+		{"abr", "abril"},
+		{"mai", "maio"},
+		{"jun", "junho"},
+		{"jul", "julho"},
+		{"ago", "agosto"},
+		{"set", "setembro"},
+		{"out", "outubro"},
+		{"nov", "novembro"},
+		{"dez", "dezembro"},
+	},
+	HMS: [3][]string{
+		{"h", "hora", "horas"},
+		{"min", "minuto", "minutos"},
+		{"s", "seg", "segundo", "segundos"},
+	},
+	AMPM: [2][]string{
+		{"am", "a"},
+		{"pm", "p"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "sem", "semana"},
+	Units: [7][]string{
+		{"seg", "segundo", "segundos"},
+		{"min", "minuto", "minutos"},
+		{"hora", "horas"},
+		{"dia", "dias"},
+		{"semana", "semanas"},
+		{"mês", "mes", "meses"},
+		{"ano", "anos"},
+	},
+	Yesterday: []string{"ontem"},
+	Today:     []string{"hoje"},
+	Tomorrow:  []string{"amanhã", "amanha"},
+	In:        []string{"em"},
+	Next:      []string{"próximo", "próxima", "proximo", "proxima"},
+	Between:   []string{"entre"},
+	Until:     []string{"até", "ate"},
+	And:       []string{"e"},
+}
+
+// The Dutch vocabulary.
+var Dutch = Vocabulary{
+	Language: "nl",
+	Jump: []string{
+		" ", ".", ",", ";", "-", "/", "'",
+		"t", "om", "op", "de", "het", "van", "en", "uur", "e",
+	},
+	Weekdays: [7][]string{
+		{"zo", "zondag"},
+		{"ma", "maandag"},
+		{"di", "dinsdag"},
+		{"wo", "woensdag"},
+		{"do", "donderdag"},
+		{"vr", "vrijdag"},
+		{"za", "zaterdag"},
+	},
+	Months: [12][]string{
+		{"jan", "januari"},
+		{"feb", "februari"},
+		{"mrt", "maart"},
+		{"apr", "april"},
+		{"mei"},
+		{"jun", "juni"},
+		{"jul", "juli"},
+		{"aug", "augustus"},
+		{"sep", "sept", "september"},
+		{"okt", "oktober"},
+		{"nov", "november"},
+		{"dec", "december"},
+	},
+	HMS: [3][]string{
+		{"h", "u", "uur"},
+		{"m", "min", "minuut", "minuten"},
+		{"s", "sec", "seconde", "seconden"},
+	},
+	AMPM: [2][]string{
+		{"am"},
+		{"pm"},
+	},
+	UTCZone: []string{"utc", "gmt", "z"},
+	Week:    []string{"w", "wk", "week"},
+	Units: [7][]string{
+		{"sec", "seconde", "seconden"},
+		{"min", "minuut", "minuten"},
+		{"uur", "uren"},
+		{"dag", "dagen"},
+		{"week", "weken"},
+		{"maand", "maanden"},
+		{"jaar", "jaren"},
+	},
+	Yesterday: []string{"gisteren"},
+	Today:     []string{"vandaag"},
+	Tomorrow:  []string{"morgen"},
+	Ago:       []string{"geleden"},
+	In:        []string{"over"},
+	Next:      []string{"volgende"},
+	Last:      []string{"vorige"},
+	Between:   []string{"tussen"},
+	Until:     []string{"tot"},
+	And:       []string{"en"},
+}
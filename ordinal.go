@@ -0,0 +1,31 @@
+package dateparser
+
+import (
+	"time"
+)
+
+// Returns the month and day of the given day of the year, where 1 January is
+// day 1. ok is false if the year does not have that many days.
+func ordinalDate(year int, yday int) (month int, day int, ok bool) {
+	daysInYear := 365
+	if time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366 {
+		daysInYear = 366
+	}
+
+	if yday < 1 || yday > daysInYear {
+		return 0, 0, false
+	}
+
+	t := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+	return int(t.Month()), t.Day(), true
+}
+
+// Returns whether tokens[i-1] (a four-digit year) and the tokens that follow
+// it form an extended ISO 8601 ordinal date such as "2003-268".
+func isOrdinalAt(tokens []string, i int) (r bool) {
+	numTokens := len(tokens)
+
+	return i+1 < numTokens && tokens[i] == "-" &&
+		len(tokens[i+1]) == 3 && isDigits(tokens[i+1]) &&
+		(i+2 >= numTokens || tokens[i+2] != "-")
+}
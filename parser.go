@@ -1,11 +1,14 @@
 package dateparser
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 var zeroTime time.Time
@@ -24,11 +27,93 @@ type ParseError struct {
 	Timestr string // The whole input string that could not be parsed.
 	Why     string // A textual description of why the parse failed.
 	Where   string // The token in the input string that caused the failure.
+
+	// The byte offsets in Timestr at which Where starts and ends, or -1 if
+	// the failure is not attributable to a single token.
+	Start int
+	End   int
+
+	// Whether or not Error includes an excerpt of the input with the
+	// offending token underlined (see Excerpt).
+	ShowExcerpt bool
+
+	// The kind of failure, which is (or wraps) one of the Err* values below.
+	// Use errors.Is on the ParseError to test for a particular kind.
+	Err error
 }
 
+// The kinds of failure reported by ParseError.
+var (
+	ErrBadNumber             = errors.New("dateparser: bad number")
+	ErrExpectedMonth         = errors.New("dateparser: expected month name")
+	ErrMultipleMonths        = errors.New("dateparser: multiple month names")
+	ErrUnknownToken          = errors.New("dateparser: unrecognised token")
+	ErrBadTZOffset           = errors.New("dateparser: bad numbered timezone")
+	ErrTooManyDateComponents = errors.New("dateparser: too many year/month/day components")
+	ErrUnknownTimezone       = errors.New("dateparser: unknown timezone")
+	ErrMixedLanguages        = errors.New("dateparser: words from different languages")
+	ErrBadDuration           = errors.New("dateparser: bad duration")
+	ErrBadInterval           = errors.New("dateparser: bad interval")
+	ErrZoneMismatch          = errors.New("dateparser: timezone disagrees with offset")
+)
+
 // Returns a string representation of the error.
 func (e ParseError) Error() string {
-	return fmt.Sprintf("Could not parse date %q: %s (at %q)", e.Timestr, e.Why, e.Where)
+	msg := fmt.Sprintf("Could not parse date %q: %s (at %q)", e.Timestr, e.Why, e.Where)
+	if e.ShowExcerpt && e.Start >= 0 {
+		msg += "\n" + e.Excerpt()
+	}
+
+	return msg
+}
+
+// Returns the kind of failure, so that errors.Is and errors.As can be used on
+// a ParseError.
+func (e ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Returns the input string followed by a line containing carets underneath
+// the bytes from Start to End, for example:
+//
+//	10 10 10 10
+//	      ^^
+//
+// Returns just the input string if the failure has no location. The input is
+// expected to be a single line.
+func (e ParseError) Excerpt() string {
+	if e.Start < 0 || e.End > len(e.Timestr) {
+		return e.Timestr
+	}
+
+	prefix := e.Timestr[:e.Start]
+	indent := strings.Map(func(c rune) rune {
+		if c == '\t' {
+			return '\t'
+		}
+		return ' '
+	}, prefix)
+
+	width := utf8.RuneCountInString(e.Timestr[e.Start:e.End])
+	if width == 0 {
+		width = 1
+	}
+
+	return e.Timestr + "\n" + indent + strings.Repeat("^", width)
+}
+
+// Returns a ParseError of the given kind for the part of the input described
+// by sp.
+func (parser *Parser) errorAt(timestr string, kind error, why string, where string, sp span) ParseError {
+	return ParseError{
+		Timestr:     timestr,
+		Why:         why,
+		Where:       where,
+		Start:       sp.start,
+		End:         sp.end,
+		ShowExcerpt: parser.ErrorExcerpts,
+		Err:         kind,
+	}
 }
 
 func parseNS(s string) (secs int, ns int, ok bool) {
@@ -63,6 +148,17 @@ func convertYear(year int) (res int) {
 	return year
 }
 
+// Returns whether s contains no letters or digits.
+func isPunctuation(s string) (r bool) {
+	for _, c := range s {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func isUpper(s string) (r bool) {
 	for _, c := range s {
 		if !unicode.IsUpper(c) {
@@ -136,108 +232,59 @@ const (
 	_PERTAIN
 )
 
-var jumpST = stBuild([]stinput{
-	stinput{" ", _JUMP},
-	stinput{".", _JUMP},
-	stinput{",", _JUMP},
-	stinput{";", _JUMP},
-	stinput{"-", _JUMP},
-	stinput{"/", _JUMP},
-	stinput{"'", _JUMP},
-	stinput{"at", _JUMP},
-	stinput{"on", _JUMP},
-	stinput{"and", _JUMP},
-	stinput{"ad", _JUMP},
-	stinput{"m", _JUMP},
-	stinput{"t", _JUMP},
-	stinput{"of", _JUMP},
-	stinput{"st", _JUMP},
-	stinput{"nd", _JUMP},
-	stinput{"rd", _JUMP},
-	stinput{"th", _JUMP},
-})
-
-var weekdayST = stBuild([]stinput{
-	stinput{"mon", _WEEKDAY_MON},
-	stinput{"tue", _WEEKDAY_TUE},
-	stinput{"tues", _WEEKDAY_TUE},
-	stinput{"wed", _WEEKDAY_WED},
-	stinput{"thu", _WEEKDAY_THU},
-	stinput{"thur", _WEEKDAY_THU},
-	stinput{"thurs", _WEEKDAY_THU},
-	stinput{"fri", _WEEKDAY_FRI},
-	stinput{"sat", _WEEKDAY_SAT},
-	stinput{"sun", _WEEKDAY_SUN},
-	stinput{"monday", _WEEKDAY_MON},
-	stinput{"tuesday", _WEEKDAY_TUE},
-	stinput{"wednesday", _WEEKDAY_WED},
-	stinput{"thursday", _WEEKDAY_THU},
-	stinput{"friday", _WEEKDAY_FRI},
-	stinput{"saturday", _WEEKDAY_SAT},
-	stinput{"sunday", _WEEKDAY_SUN},
-})
-
-var monthST = stBuild([]stinput{
-	stinput{"jan", _MONTH_JAN},
-	stinput{"feb", _MONTH_FEB},
-	stinput{"mar", _MONTH_MAR},
-	stinput{"apr", _MONTH_APR},
-	stinput{"may", _MONTH_MAY},
-	stinput{"jun", _MONTH_JUN},
-	stinput{"jul", _MONTH_JUL},
-	stinput{"aug", _MONTH_AUG},
-	stinput{"sep", _MONTH_SEP},
-	stinput{"sept", _MONTH_SEP},
-	stinput{"oct", _MONTH_OCT},
-	stinput{"nov", _MONTH_NOV},
-	stinput{"dec", _MONTH_DEC},
-	stinput{"january", _MONTH_JAN},
-	stinput{"february", _MONTH_FEB},
-	stinput{"march", _MONTH_MAR},
-	stinput{"april", _MONTH_APR},
-	stinput{"may", _MONTH_MAY},
-	stinput{"june", _MONTH_JUN},
-	stinput{"july", _MONTH_JUL},
-	stinput{"august", _MONTH_AUG},
-	stinput{"september", _MONTH_SEP},
-	stinput{"october", _MONTH_OCT},
-	stinput{"november", _MONTH_NOV},
-	stinput{"december", _MONTH_DEC},
-})
-
-var hmsST = stBuild([]stinput{
-	stinput{"h", _HMS_HOUR},
-	stinput{"m", _HMS_MINUTE},
-	stinput{"s", _HMS_SECOND},
-	stinput{"min", _HMS_MINUTE},
-	stinput{"sec", _HMS_SECOND},
-	stinput{"hour", _HMS_HOUR},
-	stinput{"minute", _HMS_MINUTE},
-	stinput{"second", _HMS_SECOND},
-	stinput{"hours", _HMS_HOUR},
-	stinput{"minutes", _HMS_MINUTE},
-	stinput{"seconds", _HMS_SECOND},
-})
-
-var ampmST = stBuild([]stinput{
-	stinput{"am", _AMPM_AM},
-	stinput{"pm", _AMPM_PM},
-	stinput{"a", _AMPM_AM},
-	stinput{"p", _AMPM_PM},
-})
-
-var utczoneST = stBuild([]stinput{
-	stinput{"utc", _UTCZONE},
-	stinput{"gmt", _UTCZONE},
-	stinput{"z", _UTCZONE},
-})
-
-func pertain(s string) (p int) {
-	if strings.ToLower(s) == "of" {
-		return _PERTAIN
+const (
+	_TOKEN_USED int = iota
+
+	_TOKEN_JUMP
+	_TOKEN_SKIPPED
+)
+
+// A piece of the input string that was skipped during a fuzzy parse.
+type Fragment struct {
+	Text  string // The text of the fragment, as it appears in the input.
+	Start int    // The byte offset in the input at which the fragment starts.
+	End   int    // The byte offset in the input at which the fragment ends.
+}
+
+// Groups the skipped tokens into fragments of the input. Runs of skipped and
+// jump tokens that contain at least one skipped token form a fragment, with
+// any leading or trailing punctuation and whitespace removed.
+func skippedFragments(timestr string, tokens []string, spans []span, usage []int) (fragments []Fragment) {
+	numTokens := len(tokens)
+	i := 0
+
+	for i < numTokens {
+		if usage[i] == _TOKEN_USED {
+			i++
+			continue
+		}
+
+		start := i
+		hasSkipped := false
+		for i < numTokens && usage[i] != _TOKEN_USED {
+			if usage[i] == _TOKEN_SKIPPED {
+				hasSkipped = true
+			}
+			i++
+		}
+
+		if !hasSkipped {
+			continue
+		}
+
+		end := i
+		for isPunctuation(tokens[start]) && usage[start] == _TOKEN_JUMP {
+			start++
+		}
+		for isPunctuation(tokens[end-1]) && usage[end-1] == _TOKEN_JUMP {
+			end--
+		}
+
+		s, e := spans[start].start, spans[end-1].end
+		fragments = append(fragments, Fragment{timestr[s:e], s, e})
 	}
 
-	return _PERTAIN_NONE
+	return fragments
 }
 
 type parseresult struct {
@@ -252,6 +299,34 @@ type parseresult struct {
 	HasTZOffset bool
 	Weekday     int
 	Year        int
+	Week        int
+	Quarter     int
+	Half        int
+	Fiscal      bool
+	Skipped     []Fragment
+
+	era          int      // The era, or _ERA_NONE.
+	calendar     Calendar // The calendar named by a marker, month name or era in the input, or nil.
+	yearIndex    int      // The index in ymd of the year written with an era, sign or suffix, or -1.
+	yearOffset   int      // Added to a year written with an era that does not start at AD 1.
+	explicitYear bool     // Whether the year is a full (astronomical) year that must not be expanded.
+
+	Zone         string // The timezone in brackets, as in "[Europe/Paris]", or named in Windows style.
+	zoneCritical bool
+	zoneLabel    bool // Whether Zone is a Windows name in parentheses, which labels the offset.
+	zoneSpan     span
+
+	tzSpan     span
+	weekSpan   span
+	periodSpan span
+	info       *ParserInfo
+
+	RelYears         int
+	RelMonths        int
+	RelDays          int
+	RelDuration      time.Duration
+	WeekdayDirection int
+	HasRelative      bool
 }
 
 // Contains the settings used in parsing. An empty structure (new(Parser) or
@@ -259,7 +334,8 @@ type parseresult struct {
 type Parser struct {
 	// The default time (from which components not present in the input are
 	// taken from). Defaults to the current time truncated to the nearest day
-	// (i.e. midnight today).
+	// (i.e. midnight today). Relative expressions such as "yesterday" or
+	// "3 hours ago" are also resolved against this time.
 	Default time.Time
 
 	// Whether or not to perform a fuzzy search. Specifically, invalid tokens
@@ -285,17 +361,98 @@ type Parser struct {
 	// all returned times have a timezone of UTC+0.
 	IgnoreTZ bool
 
+	// The vocabulary used to recognise words such as month and weekday
+	// names. If nil, DefaultParserInfo (English) is used.
+	Info *ParserInfo
+
+	// The vocabularies to choose between when the language of the input is
+	// not known in advance. If this is non-empty, Info is ignored; instead
+	// the input is parsed with the first of these whose month and weekday
+	// names agree with all of those in the input (or, if there are none, the
+	// first with which the input can be parsed). Inputs that mix month or
+	// weekday names from different languages are rejected with
+	// ErrMixedLanguages. The chosen language is reported by
+	// Result.Language.
+	Languages []*ParserInfo
+
+	// The first month of the fiscal year, used by expressions such as
+	// "FY2004" and "FY2004 Q1". Fiscal years are named after the calendar year
+	// in which they end, so with FiscalYearStart set to October "FY2004" runs
+	// from October 2003 to September 2004. Defaults to January.
+	FiscalYearStart time.Month
+
+	// Whether numeric years written without an era are in the Thai Buddhist
+	// Era, which is 543 years ahead of the Gregorian calendar, so that
+	// "25/09/2546" is 25 September 2003. Two-digit years are taken to be
+	// within 50 years of the current Buddhist Era year.
+	BuddhistEra bool
+
+	// The calendar in which dates are written, unless they are marked as Old
+	// Style (Julian) or New Style (Gregorian) as in "25 Sep 1700 O.S.". Dates
+	// in other calendars are converted to the proleptic Gregorian calendar
+	// used by time.Time. ISO week dates, quarters and fiscal years are always
+	// Gregorian. Defaults to Gregorian.
+	Calendar Calendar
+
+	// Calendars other than the Gregorian and Julian ones (such as HijriInfo
+	// and HebrewInfo) whose month names and eras are recognised. A date
+	// written with one of their month names or eras, as in "1 Ramadan 1424 AH",
+	// is in that calendar; its year is never expanded from two digits.
+	Calendars []*CalendarInfo
+
+	// Resolves timezone names to locations, which unlike the offsets in
+	// TZInfos may observe daylight saving time. It is consulted first; names
+	// that it does not know are looked up in TZInfos.
+	TZResolver TZResolver
+
 	// A map of custom timezone names to their offsets in seconds. If a timezone
-	// name is not found in this map, it is looked up using the
-	// time.LoadLocation function.
+	// name is not found in this map, it is looked up in a table of common
+	// abbreviations such as "EST" and "CEST", and then using the
+	// time.LoadLocation function. TZOffsets adapts such a map to a
+	// TZResolver.
 	TZInfos map[string]int
+
+	// The regions whose timezones are preferred when an abbreviation stands
+	// for more than one, most preferred first, as ISO 3166 country codes. For
+	// example, "CST" is US Central Standard Time unless TZRegions contains
+	// "CN" (China Standard Time) or "CU" (Cuba), and "IST" is India Standard
+	// Time unless it contains "IE" (Irish Standard Time) or "IL" (Israel).
+	TZRegions []string
+
+	// How Time handles a timezone in brackets after a numeric offset (as in
+	// "2003-09-25T10:36:28+02:00[Europe/Paris]") that disagrees with the
+	// offset at the written time. A timezone marked as critical, as in
+	// "[!Europe/Paris]", always makes Time fail if it disagrees. Defaults to
+	// ZoneMismatchError.
+	ZoneMismatch ZoneMismatchPolicy
+
+	// Whether or not an offset after "UTC" or "GMT" is read as it is usually
+	// meant, so that "UTC+3" is three hours ahead of UTC. By default it is
+	// read as in POSIX TZ strings, where offsets count west of UTC, so that
+	// "UTC+3" is three hours behind. Identifiers such as "Etc/GMT-3" always
+	// follow POSIX.
+	HumanUTCOffsets bool
+
+	// Whether or not the Error method of returned ParseErrors includes an
+	// excerpt of the input with the offending token underlined.
+	ErrorExcerpts bool
 }
 
 // Parses the input string and returns either a parsed date or an error. The
-// error may be a ParseError, or an error returned by time.LoadLocation or
-// bufio.Reader.ReadRune.
+// error may be a ParseError or an error returned by bufio.Reader.ReadRune.
 func (parser *Parser) Parse(timestr string) (t time.Time, err error) {
-	def := parser.Default
+	res, err := parser.ParseResult(timestr)
+	if err != nil {
+		return zeroTime, err
+	}
+
+	return res.Time(parser.defaultTime())
+}
+
+// Returns the default time used by Parse, which is either parser.Default or
+// midnight today if that is not set.
+func (parser *Parser) defaultTime() (def time.Time) {
+	def = parser.Default
 
 	if def.IsZero() {
 		def = time.Now()
@@ -306,101 +463,50 @@ func (parser *Parser) Parse(timestr string) (t time.Time, err error) {
 		def = time.Date(yy, mm, dd, 0, 0, 0, 0, def.Location())
 	}
 
-	res, err := parser.parseInternal(timestr)
-	if err != nil {
-		return zeroTime, err
-	}
+	return def
+}
 
-	if res.Year != -1 {
-		res.Year = convertYear(res.Year)
+func (parser *Parser) parseInternal(timestr string) (res parseresult, err error) {
+	lex := newLexer(strings.NewReader(timestr))
+	tokens, spans, err := lex.lexAll()
+	if err != nil {
+		return res, err
 	}
 
-	if res.TZOffset == 0 && (res.TZName == "" || res.TZName == "Z") {
-		res.TZName = "UTC"
-	} else if res.TZOffset != 0 && res.TZName != "" && utczoneST.search(res.TZName) != _UTCZONE_NONE {
-		res.TZOffset = 0
-	}
+	return parser.parseLexed(timestr, tokens, spans)
+}
 
-	year := res.Year
-	if year == -1 {
-		year = def.Year()
-	}
-	month := res.Month
-	if month == -1 {
-		month = int(def.Month())
-	}
-	day := res.Day
-	if day == -1 {
-		day = def.Day()
-	}
-	hour := res.Hour
-	if hour == -1 {
-		hour = def.Hour()
-	}
-	minute := res.Minute
-	if minute == -1 {
-		minute = def.Minute()
-	}
-	second := res.Second
-	if second == -1 {
-		second = def.Second()
+// Parses a sequence of tokens (as returned by lexer.lexAll) using either the
+// parser's vocabulary or, if parser.Languages is set, the first of those that
+// the tokens can be parsed with. The tokens may be modified.
+func (parser *Parser) parseLexed(timestr string, tokens []string, spans []span) (res parseresult, err error) {
+	if len(parser.Languages) == 0 {
+		return parser.parseTokens(parser.info(), timestr, tokens, spans)
 	}
 
-	nanosecond := def.Nanosecond()
-	if res.Nanosecond != -1 {
-		nanosecond = res.Nanosecond
+	candidates, err := parser.detectLanguages(timestr, tokens, spans)
+	if err != nil {
+		return res, err
 	}
 
-	loc := def.Location()
-
-	if parser.IgnoreTZ {
-		loc = time.FixedZone("UTC", 0)
-
-	} else {
-		if res.TZName != "" {
-			if res.HasTZOffset {
-				loc = time.FixedZone(res.TZName, res.TZOffset)
-
-			} else {
-				ok := false
-				if parser.TZInfos != nil {
-					var offset int
-					offset, ok = parser.TZInfos[res.TZName]
-					if ok {
-						loc = time.FixedZone(res.TZName, offset)
-					}
-				}
-
-				if !ok {
-					loc, err = time.LoadLocation(res.TZName)
-					if err != nil {
-						return zeroTime, err
-					}
-				}
-			}
-
-		} else if res.HasTZOffset {
-			loc = time.FixedZone("", res.TZOffset)
+	var firstErr error
+	for _, info := range candidates {
+		res, err = parser.parseTokens(info, timestr, append([]string(nil), tokens...), spans)
+		if err == nil {
+			return res, nil
 		}
-	}
-
-	t = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc)
 
-	if res.Weekday != -1 && res.Day == -1 {
-		weekdayOffset := (res.Weekday - int(t.Weekday()) + 7) % 7
-		t = t.Add(time.Duration(weekdayOffset*24) * time.Hour)
+		if firstErr == nil {
+			firstErr = err
+		}
 	}
 
-	return t, nil
+	return res, firstErr
 }
 
-func (parser *Parser) parseInternal(timestr string) (res parseresult, err error) {
-	lex := newLexer(strings.NewReader(timestr))
-	tokens, err := lex.lexAll()
-	if err != nil {
-		return res, err
-	}
-
+// Parses a sequence of tokens (as returned by lexer.lexAll) using the
+// vocabulary in info. The tokens may be modified.
+func (parser *Parser) parseTokens(info *ParserInfo, timestr string, tokens []string, spans []span) (res parseresult, err error) {
 	var parseIntResult64 int64
 
 	res = parseresult{
@@ -415,17 +521,26 @@ func (parser *Parser) parseInternal(timestr string) (res parseresult, err error)
 		HasTZOffset: false,
 		Weekday:     -1,
 		Year:        -1,
+		Week:        -1,
+		Quarter:     -1,
+		Half:        -1,
+		info:        info,
+
+		era:       _ERA_NONE,
+		yearIndex: -1,
 	}
 
 	i := 0
 	numTokens := len(tokens)
 	monthNameIndex := -1
 	ymd := make([]int, 0, 3)
+	usage := make([]int, numTokens)
 
 loop:
 	for i < numTokens {
 
 		token := tokens[i]
+		tokenIndex := i
 		value, err := strconv.ParseFloat(tokens[i], 64)
 		isNumber := err == nil
 
@@ -433,20 +548,28 @@ loop:
 			tokenLength := len(token)
 			i++
 
+			relUnit, relSign, relNext := relativeSuffixAt(info, tokens, i)
+
 			switch {
+			case relUnit != _UNIT_NONE:
+				if !res.addRelative(relUnit, float64(relSign)*value) {
+					return res, parser.errorAt(timestr, ErrBadNumber, "Fractional month or year offset", token, spans[tokenIndex])
+				}
+				i = relNext
+
 			// timestamps since unix "epoch" (sec, ms, or ns).  Valid for timestamps starting
 			// 2001-09-09 and ending 2286-11-20.
 			case numTokens == 1 && (tokenLength == 10 || tokenLength == 13 || tokenLength == 19):
 				s, err := strconv.ParseInt(token[0:10], 10, 64)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token, spans[tokenIndex])
 				}
 
 				var ns int64
 				if tokenLength > 10 {
 					ns, err = strconv.ParseInt(token[10:], 10, 64)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[10:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[10:], spans[tokenIndex].sub(10, len(token)))
 					}
 				}
 				if tokenLength == 13 {
@@ -465,11 +588,11 @@ loop:
 			case len(ymd) == 3 &&
 				(tokenLength == 2 || tokenLength == 4) &&
 				(i >= numTokens ||
-					((tokens[i] != ":") && hmsST.search(tokens[i]) == _HMS_NONE)):
+					((tokens[i] != ":") && info.hms.search(tokens[i]) == _HMS_NONE)):
 
 				parseIntResult64, err = strconv.ParseInt(token[:2], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[:2]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[:2], spans[tokenIndex].sub(0, 2))
 				}
 
 				res.Hour = int(parseIntResult64)
@@ -477,7 +600,7 @@ loop:
 				if tokenLength == 4 {
 					parseIntResult64, err = strconv.ParseInt(token[2:], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[2:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[2:], spans[tokenIndex].sub(2, len(token)))
 					}
 
 					res.Minute = int(parseIntResult64)
@@ -488,19 +611,19 @@ loop:
 				if len(ymd) == 0 && findPeriod(token) == -1 {
 					parseIntResult64, err = strconv.ParseInt(token[:2], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[:2]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[:2], spans[tokenIndex].sub(0, 2))
 					}
 
 					ymd = append(ymd, convertYear(int(parseIntResult64)))
 					parseIntResult64, err = strconv.ParseInt(token[2:4], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[2:4]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[2:4], spans[tokenIndex].sub(2, 4))
 					}
 
 					ymd = append(ymd, int(parseIntResult64))
 					parseIntResult64, err = strconv.ParseInt(token[4:], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[4:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[4:], spans[tokenIndex].sub(4, len(token)))
 					}
 
 					ymd = append(ymd, int(parseIntResult64))
@@ -509,41 +632,62 @@ loop:
 
 					parseIntResult64, err = strconv.ParseInt(token[:2], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[:2]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[:2], spans[tokenIndex].sub(0, 2))
 					}
 
 					res.Hour = int(parseIntResult64)
 					parseIntResult64, err = strconv.ParseInt(token[2:4], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[2:4]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[2:4], spans[tokenIndex].sub(2, 4))
 					}
 
 					res.Minute = int(parseIntResult64)
 					parsens_sec, parsens_ns, parsens_ok := parseNS(token[4:])
 					if !parsens_ok {
-						return res, ParseError{timestr, "Could not parse sec/ms", token[4:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse sec/ms", token[4:], spans[tokenIndex].sub(4, len(token)))
 					}
 					res.Second = parsens_sec
 					res.Nanosecond = parsens_ns
 				}
 
+			case tokenLength == 7 && isDigits(token):
+				year, _ := strconv.Atoi(token[:4])
+				yday, _ := strconv.Atoi(token[4:])
+				month, day, ok := ordinalDate(year, yday)
+				if !ok {
+					return res, parser.errorAt(timestr, ErrBadNumber, "Day of year out of range", token[4:], spans[tokenIndex].sub(4, len(token)))
+				}
+
+				ymd = append(ymd, year, month, day)
+
+			case tokenLength == 4 && isDigits(token) && isOrdinalAt(tokens, i):
+				year := int(value)
+				yday, _ := strconv.Atoi(tokens[i+1])
+				month, day, ok := ordinalDate(year, yday)
+				if !ok {
+					return res, parser.errorAt(timestr, ErrBadNumber, "Day of year out of range", tokens[i+1], spans[i+1])
+				}
+
+				ymd = append(ymd, year, month, day)
+				i += 2
+
 			case tokenLength == 8:
 
 				parseIntResult64, err = strconv.ParseInt(token[:4], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[:4]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[:4], spans[tokenIndex].sub(0, 4))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
 				parseIntResult64, err = strconv.ParseInt(token[4:6], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[4:6]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[4:6], spans[tokenIndex].sub(4, 6))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
 				parseIntResult64, err = strconv.ParseInt(token[6:], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[6:]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[6:], spans[tokenIndex].sub(6, len(token)))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
@@ -552,31 +696,31 @@ loop:
 
 				parseIntResult64, err = strconv.ParseInt(token[:4], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[:4]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[:4], spans[tokenIndex].sub(0, 4))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
 				parseIntResult64, err = strconv.ParseInt(token[4:6], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[4:6]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[4:6], spans[tokenIndex].sub(4, 6))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
 				parseIntResult64, err = strconv.ParseInt(token[6:8], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[6:8]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[6:8], spans[tokenIndex].sub(6, 8))
 				}
 
 				ymd = append(ymd, int(parseIntResult64))
 				parseIntResult64, err = strconv.ParseInt(token[8:10], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[8:10]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[8:10], spans[tokenIndex].sub(8, 10))
 				}
 
 				res.Hour = int(parseIntResult64)
 				parseIntResult64, err = strconv.ParseInt(token[10:12], 10, 0)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", token[10:12]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[10:12], spans[tokenIndex].sub(10, 12))
 				}
 
 				res.Minute = int(parseIntResult64)
@@ -584,20 +728,20 @@ loop:
 				if tokenLength == 14 {
 					parseIntResult64, err = strconv.ParseInt(token[12:], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", token[12:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", token[12:], spans[tokenIndex].sub(12, len(token)))
 					}
 
 					res.Second = int(parseIntResult64)
 				}
 
-			case (i < numTokens && hmsST.search(tokens[i]) != _HMS_NONE) ||
-				(i+1 < numTokens && tokens[i] == " " && hmsST.search(tokens[i+1]) != _HMS_NONE):
+			case (i < numTokens && info.hms.search(tokens[i]) != _HMS_NONE) ||
+				(i+1 < numTokens && tokens[i] == " " && info.hms.search(tokens[i+1]) != _HMS_NONE):
 
 				if tokens[i] == " " {
 					i++
 				}
 
-				hmstype := hmsST.search(tokens[i])
+				hmstype := info.hms.search(tokens[i])
 				for {
 					switch hmstype {
 					case _HMS_HOUR:
@@ -615,7 +759,7 @@ loop:
 					case _HMS_SECOND:
 						parsens_sec, parsens_ns, parsens_ok := parseNS(token)
 						if !parsens_ok {
-							return res, ParseError{timestr, "Could not parse sec/ms", token}
+							return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse sec/ms", token, spans[tokenIndex])
 						}
 						res.Second = parsens_sec
 						res.Nanosecond = parsens_ns
@@ -627,6 +771,7 @@ loop:
 					}
 
 					token = tokens[i]
+					tokenIndex = i
 					value, err = strconv.ParseFloat(tokens[i], 64)
 					if err != nil {
 						break
@@ -634,7 +779,7 @@ loop:
 						i++
 						hmstype++
 						if i < numTokens {
-							newhmstype := hmsST.search(tokens[i])
+							newhmstype := info.hms.search(tokens[i])
 							if newhmstype != _HMS_NONE {
 								hmstype = newhmstype
 							}
@@ -642,9 +787,9 @@ loop:
 					}
 				}
 
-			case i == numTokens && i >= 3 && tokens[i-2] == " " && hmsST.search(tokens[i-3]) != _HMS_NONE:
+			case i == numTokens && i >= 3 && tokens[i-2] == " " && info.hms.search(tokens[i-3]) != _HMS_NONE:
 
-				hms := hmsST.search(tokens[i-3]) + 1
+				hms := info.hms.search(tokens[i-3]) + 1
 				if hms == _HMS_MINUTE {
 					res.Minute = int(value)
 					if hasFractional(value) {
@@ -654,7 +799,7 @@ loop:
 				} else if hms == _HMS_SECOND {
 					parsens_sec, parsens_ns, parsens_ok := parseNS(token)
 					if !parsens_ok {
-						return res, ParseError{timestr, "Could not parse sec/ms", token}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse sec/ms", token, spans[tokenIndex])
 					}
 					res.Second = parsens_sec
 					res.Nanosecond = parsens_ns
@@ -668,17 +813,17 @@ loop:
 				i++
 				value, err = strconv.ParseFloat(tokens[i], 64)
 				if err != nil {
-					return res, ParseError{timestr, "Could not parse number", tokens[i]}
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
 				}
 				res.Minute = int(value)
 				if hasFractional(value) {
 					res.Second = int(60.0 * getFractional(value))
 				}
 				i++
-				if i < numTokens && tokens[i] == ":" {
+				if i+1 < numTokens && tokens[i] == ":" {
 					parsens_sec, parsens_ns, parsens_ok := parseNS(tokens[i+1])
 					if !parsens_ok {
-						return res, ParseError{timestr, "Could not parse sec/ns", tokens[i+1]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse sec/ns", tokens[i+1], spans[i+1])
 					}
 					res.Second = parsens_sec
 					res.Nanosecond = parsens_ns
@@ -690,20 +835,20 @@ loop:
 				ymd = append(ymd, int(value))
 				i++
 
-				if i < numTokens && jumpST.search(tokens[i]) == _JUMP_NONE {
+				if i < numTokens && info.jump.search(tokens[i]) == _JUMP_NONE && info.week.search(tokens[i]) == _WEEK_NONE && info.period.search(tokens[i]) == _PERIOD_NONE {
 					v, err := strconv.ParseInt(tokens[i], 10, 0)
 					if err == nil {
 
 						ymd = append(ymd, int(v))
 					} else {
 
-						month := monthST.search(tokens[i])
+						month := info.month.search(tokens[i])
 						if month == _MONTH_NONE {
-							return res, ParseError{timestr, "Expected month name", tokens[i]}
+							return res, parser.errorAt(timestr, ErrExpectedMonth, "Expected month name", tokens[i], spans[i])
 						}
 						ymd = append(ymd, month)
 						if monthNameIndex != -1 {
-							return res, ParseError{timestr, "Multiple month names found", tokens[i]}
+							return res, parser.errorAt(timestr, ErrMultipleMonths, "Multiple month names found", tokens[i], spans[i])
 						}
 						monthNameIndex = len(ymd) - 1
 					}
@@ -712,17 +857,21 @@ loop:
 					if i < numTokens && tokens[i] == sep {
 
 						i++
-						month := monthST.search(tokens[i])
+						if i >= numTokens {
+							return res, parser.errorAt(timestr, ErrBadNumber, "Missing number after separator", sep, spans[i-1])
+						}
+
+						month := info.month.search(tokens[i])
 						if month != _MONTH_NONE {
 							ymd = append(ymd, month)
 							if monthNameIndex != -1 {
-								return res, ParseError{timestr, "Multiple month names found", tokens[i]}
+								return res, parser.errorAt(timestr, ErrMultipleMonths, "Multiple month names found", tokens[i], spans[i])
 							}
 							monthNameIndex = len(ymd)
 						} else {
 							parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
 							if err != nil {
-								return res, ParseError{timestr, "Could not parse number", tokens[i]}
+								return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
 							}
 
 							ymd = append(ymd, int(parseIntResult64))
@@ -731,10 +880,10 @@ loop:
 					}
 				}
 
-			case i >= numTokens || jumpST.search(tokens[i]) != _JUMP_NONE:
-				if i+1 < numTokens && ampmST.search(tokens[i+1]) != _AMPM_NONE {
+			case i >= numTokens || info.jump.search(tokens[i]) != _JUMP_NONE:
+				if i+1 < numTokens && info.ampm.search(tokens[i+1]) != _AMPM_NONE && !parser.isEraAt(info, tokens, i+1) {
 
-					ampm := ampmST.search(tokens[i+1])
+					ampm := info.ampm.search(tokens[i+1])
 					res.Hour = int(value)
 					if res.Hour < 12 && ampm == _AMPM_PM {
 						res.Hour += 12
@@ -748,9 +897,31 @@ loop:
 					ymd = append(ymd, int(value))
 				}
 
-			case ampmST.search(tokens[i]) != _AMPM_NONE:
+			case info.week.search(tokens[i]) != _WEEK_NONE:
+				ymd = append(ymd, int(value))
+
+			case info.suffix.search(tokens[i]) != _SUFFIX_NONE:
+				ymd = append(ymd, int(value))
+
+			case info.period.search(tokens[i]) != _PERIOD_NONE:
+				period := info.period.search(tokens[i])
+				if tokenLength == 1 && period != _PERIOD_FISCAL && i+1 < numTokens && isDigits(tokens[i+1]) {
+					// A quarter or half followed by the year, as in "3Q03".
+					res.periodSpan = span{spans[tokenIndex].start, spans[i].end}
+					if !res.setPeriod(period, int(value)) {
+						return res, parser.errorAt(timestr, ErrBadNumber, "Quarter or half repeated or out of range", timestr[res.periodSpan.start:res.periodSpan.end], res.periodSpan)
+					}
 
-				ampm := ampmST.search(tokens[i])
+					year, _ := strconv.Atoi(tokens[i+1])
+					ymd = append(ymd, year)
+					i += 2
+				} else {
+					ymd = append(ymd, int(value))
+				}
+
+			case info.ampm.search(tokens[i]) != _AMPM_NONE:
+
+				ampm := info.ampm.search(tokens[i])
 				res.Hour = int(value)
 				if res.Hour < 12 && ampm == _AMPM_PM {
 					res.Hour += 12
@@ -761,38 +932,119 @@ loop:
 
 			default:
 				if parser.Fuzzy {
+					usage[i-1] = _TOKEN_SKIPPED
+					usage[i] = _TOKEN_SKIPPED
 					i++
 				} else {
-					return res, ParseError{timestr, "Unrecognised token", tokens[i]}
+					return res, parser.errorAt(timestr, ErrUnknownToken, "Unrecognised token", tokens[i], spans[i])
 				}
 			}
 
 		} else {
 
-			weekday := weekdayST.search(tokens[i])
+			zoneNext, ok := res.parseZoneSuffix(timestr, tokens, spans, i)
+			if !ok {
+				return res, parser.errorAt(timestr, ErrUnknownToken, "Unsupported timezone or tag in brackets", timestr[res.zoneSpan.start:res.zoneSpan.end], res.zoneSpan)
+			}
+			if zoneNext != -1 {
+				i = zoneNext
+				continue loop
+			}
+
+			if name, zoneNext := zoneIDAt(timestr, tokens, spans, i); zoneNext != -1 && res.TZName == "" {
+				res.TZName = name
+				res.tzSpan = span{spans[i].start, spans[zoneNext-1].end}
+				if offset, ok := etcOffset(name); ok && !res.HasTZOffset {
+					res.TZOffset = offset
+					res.HasTZOffset = true
+				}
+				i = zoneNext
+				continue loop
+			}
+
+			if zone, zoneNext := windowsZoneAt(timestr, tokens, spans, i); zoneNext != -1 && res.Zone == "" {
+				res.Zone = zone
+				res.zoneSpan = span{spans[i].start, spans[zoneNext-1].end}
+				i = zoneNext
+				continue loop
+			}
+
+			if isSignedYearAt(tokens, i) {
+				year, err := strconv.Atoi(tokens[i+1])
+				if err != nil {
+					return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i+1], spans[i+1])
+				}
+				if tokens[i] == "-" {
+					year = -year
+				}
+
+				res.yearIndex = len(ymd)
+				res.explicitYear = true
+				ymd = append(ymd, year)
+				i += 2
+				continue loop
+			}
+
+			// A suffix marks the number before it, as in "2003年9月25日".
+			suffix := info.suffix.search(tokens[i])
+			if suffix != _SUFFIX_NONE && i > 0 && isDigits(tokens[i-1]) && len(ymd) > 0 {
+				switch suffix {
+				case _SUFFIX_YEAR:
+					if res.yearIndex == -1 {
+						res.yearIndex = len(ymd) - 1
+					}
+				case _SUFFIX_MONTH:
+					if monthNameIndex != -1 {
+						return res, parser.errorAt(timestr, ErrMultipleMonths, "Multiple months found", tokens[i], spans[i])
+					}
+					monthNameIndex = len(ymd) - 1
+				}
+
+				i++
+				continue loop
+			}
+
+			weekday := info.weekday.search(tokens[i])
 			if weekday != _WEEKDAY_NONE {
 				res.Weekday = weekday
 				i++
 				continue loop
 			}
 
-			month := monthST.search(tokens[i])
+			month := info.month.search(tokens[i])
+			monthEnd := i + 1
+			if month == _MONTH_NONE {
+				var cal Calendar
+				cal, month, monthEnd = parser.calendarMonthAt(tokens, i)
+				if month != _MONTH_NONE {
+					if !res.setCalendar(cal) {
+						return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Dates from different calendars found", tokens[i], spans[i])
+					}
+
+					// Years in other calendars are not expanded.
+					res.explicitYear = true
+				}
+			}
 			if month != _MONTH_NONE {
 				ymd = append(ymd, month)
 				if monthNameIndex != -1 {
-					return res, ParseError{timestr, "Multiple month names found", tokens[i]}
+					return res, parser.errorAt(timestr, ErrMultipleMonths, "Multiple month names found", tokens[i], spans[i])
 				}
 				monthNameIndex = len(ymd) - 1
-				i++
+				i = monthEnd
 
 				if i < numTokens {
 					sep := tokens[i]
 					if sep == "-" || sep == "/" {
 
 						i++
+						if i >= numTokens {
+							return res, parser.errorAt(timestr, ErrBadNumber, "Missing number after separator", sep, spans[i-1])
+						}
+
 						parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
 						if err != nil {
-							return res, ParseError{timestr, "Could not parse number", tokens[i]}
+							return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
 						}
 
 						ymd = append(ymd, int(parseIntResult64))
@@ -800,16 +1052,20 @@ loop:
 						if i < numTokens && tokens[i] == sep {
 
 							i++
+							if i >= numTokens {
+								return res, parser.errorAt(timestr, ErrBadNumber, "Missing number after separator", sep, spans[i-1])
+							}
+
 							parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
 							if err != nil {
-								return res, ParseError{timestr, "Could not parse number", tokens[i]}
+								return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
 							}
 
 							ymd = append(ymd, int(parseIntResult64))
 							i++
 						}
 
-					} else if i+3 < numTokens && tokens[i] == " " && tokens[i+2] == " " && pertain(tokens[i+1]) != _PERTAIN_NONE {
+					} else if i+3 < numTokens && tokens[i] == " " && tokens[i+2] == " " && info.pertain.search(tokens[i+1]) != _PERTAIN_NONE {
 
 						year, err := strconv.ParseInt(tokens[i+3], 10, 0)
 						if err == nil {
@@ -822,7 +1078,81 @@ loop:
 				continue loop
 			}
 
-			ampm := ampmST.search(tokens[i])
+			next, ok := res.parseWeek(info, tokens, spans, i)
+			if !ok {
+				return res, parser.errorAt(timestr, ErrBadNumber, "Week or day of week out of range", timestr[res.weekSpan.start:res.weekSpan.end], res.weekSpan)
+			}
+			if next != -1 {
+				i = next
+				continue loop
+			}
+
+			era, next := eraAt(info, tokens, i)
+			if era == _ERA_NONE {
+				if cal, calNext := parser.calendarEraAt(tokens, i); cal != nil {
+					if !res.setCalendar(cal) {
+						return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Dates from different calendars found", tokens[i], spans[i])
+					}
+					era, next = _ERA_AD, calNext
+				}
+			}
+			firstYear := false
+			if era == _ERA_NONE {
+				var start int
+				start, firstYear, next = yearEraAt(info, tokens, i)
+				if next != -1 {
+					era = _ERA_AD
+					res.yearOffset = start - 1
+				}
+			}
+			if era != _ERA_NONE {
+				if res.era != _ERA_NONE {
+					return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Multiple eras found", tokens[i], spans[i])
+				}
+
+				// The era applies to the number before it (as in "44 BC") or
+				// else to the one after it (as in "AD 800").
+				res.era = era
+				res.explicitYear = true
+				res.yearIndex = len(ymd)
+				k := i - 1
+				for k >= 0 && (tokens[k] == " " || tokens[k] == ",") {
+					k--
+				}
+				if k >= 0 && isDigits(tokens[k]) && len(ymd) > 0 {
+					res.yearIndex = len(ymd) - 1
+				}
+
+				// "平成元年" is the first year of the era.
+				if firstYear {
+					res.yearIndex = len(ymd)
+					ymd = append(ymd, 1)
+				}
+
+				i = next
+				continue loop
+			}
+
+			style, next := dottedWordAt(info.style, tokens, i)
+			if style != _STYLE_NONE {
+				if !res.setCalendar(styleCalendar(style)) {
+					return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Dates from different calendars found", tokens[i], spans[i])
+				}
+
+				i = next
+				continue loop
+			}
+
+			next, ok = res.parsePeriodWord(info, tokens, spans, &ymd, i)
+			if !ok {
+				return res, parser.errorAt(timestr, ErrBadNumber, "Quarter or half repeated or out of range", timestr[res.periodSpan.start:res.periodSpan.end], res.periodSpan)
+			}
+			if next != -1 {
+				i = next
+				continue loop
+			}
+
+			ampm := info.ampm.search(tokens[i])
 			if ampm != _AMPM_NONE {
 				if res.Hour < 12 && ampm == _AMPM_PM {
 					res.Hour += 12
@@ -834,30 +1164,42 @@ loop:
 				continue loop
 			}
 
+			next, ok = res.parseRelativeWord(info, tokens, i)
+			if !ok {
+				return res, parser.errorAt(timestr, ErrBadNumber, "Fractional month or year offset", tokens[i], spans[i])
+			}
+			if next != -1 {
+				i = next
+				continue loop
+			}
+
 			if res.Hour != -1 && len(tokens[i]) <= 5 && isUpper(tokens[i]) {
 				res.TZName = tokens[i]
-				if utczoneST.search(res.TZName) != _UTCZONE_NONE {
+				res.tzSpan = spans[i]
+				if info.utczone.search(res.TZName) != _UTCZONE_NONE {
 					res.TZOffset = 0
 					res.HasTZOffset = true
 				}
 
 				i++
 
-				if i < numTokens {
-					if tokens[i] == "+" {
-						tokens[i] = "-"
-						res.HasTZOffset = false
-						if utczoneST.search(res.TZName) != _UTCZONE_NONE {
-							res.TZName = ""
-						}
+				if i < numTokens && (tokens[i] == "+" || tokens[i] == "-") {
+					utc := info.utczone.search(res.TZName) != _UTCZONE_NONE
 
-					} else if tokens[i] == "-" {
-						tokens[i] = "+"
-						res.HasTZOffset = false
-						if utczoneST.search(res.TZName) != _UTCZONE_NONE {
-							res.TZName = ""
+					// In POSIX TZ strings offsets count west of UTC, as in
+					// "BRST+3".
+					if !utc || !parser.HumanUTCOffsets {
+						if tokens[i] == "+" {
+							tokens[i] = "-"
+						} else {
+							tokens[i] = "+"
 						}
 					}
+
+					res.HasTZOffset = false
+					if utc {
+						res.TZName = ""
+					}
 				}
 
 				continue loop
@@ -870,89 +1212,204 @@ loop:
 				}
 
 				i++
+				if i >= numTokens {
+					return res, parser.errorAt(timestr, ErrBadTZOffset, "Missing timezone offset", tokens[i-1], spans[i-1])
+				}
+
 				tokenLength := len(tokens[i])
+				offsetStart := i
+				hours, minutes, seconds := 0, 0, 0
 
-				if tokenLength == 4 {
+				if strings.Contains(tokens[i], ".") {
+
+					// A fractional number of hours, as in "UTC+5.5".
+					var fractional float64
+					fractional, err = strconv.ParseFloat(tokens[i], 64)
+					if err != nil || fractional >= 24 {
+						return res, parser.errorAt(timestr, ErrBadTZOffset, "Bad numbered timezone", tokens[i], spans[i])
+					}
+
+					total := int(math.Round(fractional * 3600))
+					hours, minutes, seconds = total/3600, total/60%60, total%60
+
+				} else if tokenLength == 4 {
 
 					parseIntResult64, err = strconv.ParseInt(tokens[i][:2], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", tokens[i][:2]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i][:2], spans[i].sub(0, 2))
 					}
 
-					a := int(parseIntResult64)
+					hours = int(parseIntResult64)
 					parseIntResult64, err = strconv.ParseInt(tokens[i][2:], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", tokens[i][2:]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i][2:], spans[i].sub(2, len(tokens[i])))
 					}
 
-					b := int(parseIntResult64)
-					res.TZOffset = a*3600 + b*60
-					res.HasTZOffset = true
+					minutes = int(parseIntResult64)
+
+				} else if tokenLength == 6 {
+
+					parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
+					if err != nil {
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
+					}
+
+					hhmmss := int(parseIntResult64)
+					hours, minutes, seconds = hhmmss/10000, hhmmss/100%100, hhmmss%100
 
 				} else if i+1 < numTokens && tokens[i+1] == ":" {
 
 					parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", tokens[i]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
+					}
+
+					hours = int(parseIntResult64)
+					if i+2 >= numTokens {
+						return res, parser.errorAt(timestr, ErrBadTZOffset, "Bad numbered timezone", timestr[spans[i].start:spans[i+1].end], span{spans[i].start, spans[i+1].end})
 					}
 
-					a := int(parseIntResult64)
 					parseIntResult64, err = strconv.ParseInt(tokens[i+2], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", tokens[i+2]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i+2], spans[i+2])
 					}
 
-					b := int(parseIntResult64)
-					res.TZOffset = a*3600 + b*60
-					res.HasTZOffset = true
+					minutes = int(parseIntResult64)
 					i += 2
 
+					if i+2 < numTokens && tokens[i+1] == ":" {
+						parseIntResult64, err = strconv.ParseInt(tokens[i+2], 10, 0)
+						if err != nil {
+							return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i+2], spans[i+2])
+						}
+
+						seconds = int(parseIntResult64)
+						i += 2
+					}
+
 				} else if tokenLength <= 2 {
 
 					parseIntResult64, err = strconv.ParseInt(tokens[i], 10, 0)
 					if err != nil {
-						return res, ParseError{timestr, "Could not parse number", tokens[i]}
+						return res, parser.errorAt(timestr, ErrBadNumber, "Could not parse number", tokens[i], spans[i])
 					}
 
-					res.TZOffset = int(parseIntResult64) * 3600
-					res.HasTZOffset = true
+					hours = int(parseIntResult64)
 
 				} else {
-					return res, ParseError{timestr, "Bad numbered timezone", tokens[i]}
+					return res, parser.errorAt(timestr, ErrBadTZOffset, "Bad numbered timezone", tokens[i], spans[i])
 				}
 
+				if hours >= 24 || minutes >= 60 || seconds >= 60 {
+					offsetSpan := span{spans[offsetStart].start, spans[i].end}
+					return res, parser.errorAt(timestr, ErrBadTZOffset, "Timezone offset out of range", timestr[offsetSpan.start:offsetSpan.end], offsetSpan)
+				}
+
+				res.TZOffset = sign * (hours*3600 + minutes*60 + seconds)
+				res.HasTZOffset = true
 				i++
-				res.TZOffset *= sign
 
-				if i+3 < numTokens && jumpST.search(tokens[i]) != _JUMP_NONE && tokens[i+1] == "(" && tokens[i+3] == ")" && len(tokens[i+2]) >= 3 && len(tokens[i+2]) <= 5 && isUpper(tokens[i+2]) {
+				if i+3 < numTokens && info.jump.search(tokens[i]) != _JUMP_NONE && tokens[i+1] == "(" && tokens[i+3] == ")" && len(tokens[i+2]) >= 3 && len(tokens[i+2]) <= 5 && isUpper(tokens[i+2]) {
 
 					res.TZName = tokens[i+2]
+					res.tzSpan = spans[i+2]
 					i += 4
+
+				} else if i+2 < numTokens && info.jump.search(tokens[i]) != _JUMP_NONE && tokens[i+1] == "(" && res.Zone == "" {
+
+					// A Windows timezone name, as in "-0800 (Pacific Standard Time)".
+					zone, zoneNext := windowsZoneAt(timestr, tokens, spans, i+2)
+					if zoneNext != -1 && zoneNext < numTokens && tokens[zoneNext] == ")" {
+						res.Zone = zone
+						res.zoneLabel = true
+						res.zoneSpan = span{spans[i+2].start, spans[zoneNext-1].end}
+						i = zoneNext + 1
+					}
 				}
 
 				continue loop
 			}
 
-			if jumpST.search(tokens[i]) != _JUMP_NONE {
+			if info.jump.search(tokens[i]) != _JUMP_NONE {
+				usage[i] = _TOKEN_JUMP
 				i++
 				continue loop
 
 			}
 
 			if parser.Fuzzy {
+				usage[i] = _TOKEN_SKIPPED
 				i++
 				continue loop
 			} else {
-				return res, ParseError{timestr, "Unrecognised token", tokens[i]}
+				return res, parser.errorAt(timestr, ErrUnknownToken, "Unrecognised token", tokens[i], spans[i])
+			}
+		}
+	}
+
+	if parser.Fuzzy {
+		res.Skipped = skippedFragments(timestr, tokens, spans, usage)
+	}
+
+	if res.yearIndex != -1 {
+		// A number that can only be a year takes precedence over the one
+		// next to the era, as in "1996.07.10 AD".
+		if res.era != _ERA_NONE {
+			for index, value := range ymd {
+				if index != monthNameIndex && value > 31 {
+					res.yearIndex = index
+					break
+				}
 			}
 		}
+
+		if !parser.assignWithYear(&res, ymd, res.yearIndex, monthNameIndex) {
+			return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Could not find the year for the era", "<no-specific-location>", span{-1, -1})
+		}
+
+		switch res.era {
+		case _ERA_BC:
+			if res.Year < 1 {
+				return res, parser.errorAt(timestr, ErrBadNumber, "Year out of range for era", strconv.Itoa(res.Year), span{-1, -1})
+			}
+			res.Year = 1 - res.Year
+		case _ERA_AD:
+			if res.Year < 1 {
+				return res, parser.errorAt(timestr, ErrBadNumber, "Year out of range for era", strconv.Itoa(res.Year), span{-1, -1})
+			}
+			res.Year += res.yearOffset
+		}
+
+		return res, nil
+	}
+
+	if res.Quarter != -1 || res.Half != -1 || res.Fiscal {
+		if len(ymd) > 1 || monthNameIndex != -1 || res.Week != -1 {
+			return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Quarter, half or fiscal year with a month, week or day", timestr[res.periodSpan.start:res.periodSpan.end], res.periodSpan)
+		}
+		if len(ymd) == 1 {
+			res.Year = ymd[0]
+		}
+
+		return res, nil
+	}
+
+	if res.Week != -1 {
+		if len(ymd) > 1 || monthNameIndex != -1 {
+			return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Week date with a month or day", timestr[res.weekSpan.start:res.weekSpan.end], res.weekSpan)
+		}
+		if len(ymd) == 1 {
+			res.Year = ymd[0]
+		}
+
+		return res, nil
 	}
 
 	numYMD := len(ymd)
 
 	switch {
 	case numYMD > 3:
-		return res, ParseError{timestr, "Too many year/month/day components found", "<no-specific-location>"}
+		return res, parser.errorAt(timestr, ErrTooManyDateComponents, "Too many year/month/day components found", "<no-specific-location>", span{-1, -1})
 
 	case numYMD == 1 || (monthNameIndex != -1 && numYMD == 2):
 		if monthNameIndex != -1 {
@@ -1,6 +1,7 @@
 package dateparser
 
 import (
+    "errors"
     "fmt"
     "math/rand"
     "testing"
@@ -749,6 +750,153 @@ func TestFuzzy2(t *testing.T) {
     }
 }
 
+func TestFuzzyWithTokens(t *testing.T) {
+    parser := &Parser{}
+    s := "Today is 25 of September of 2003, exactly at 10:49:41 with timezone -03:00."
+    res, skipped, err := parser.ParseFuzzyWithTokens(s)
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    expect := time.Date(2003, 9, 25, 10, 49, 41, 0, BRSTLoc)
+    if !res.Equal(expect) {
+        t.Errorf("Expected '%s', parsed '%s'", expect, res)
+    }
+    
+    expectSkipped := []Fragment{
+        Fragment{"is", 6, 8},
+        Fragment{"exactly at", 34, 44},
+        Fragment{"with timezone", 54, 67},
+    }
+    
+    if len(skipped) != len(expectSkipped) {
+        t.Fatalf("Expected skipped fragments %v, got %v", expectSkipped, skipped)
+    }
+    
+    for i, fragment := range skipped {
+        if fragment != expectSkipped[i] {
+            t.Errorf("Expected skipped fragment %v, got %v", expectSkipped[i], fragment)
+        }
+        
+        if s[fragment.Start:fragment.End] != fragment.Text {
+            t.Errorf("Fragment %q does not match input at %d:%d", fragment.Text, fragment.Start, fragment.End)
+        }
+    }
+}
+
+func TestFuzzyWithTokensCommand(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    s := "remind me on Sep 30 at 5pm to call bob"
+    res, skipped, err := parser.ParseFuzzyWithTokens(s)
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    expect := time.Date(2003, 9, 30, 17, 0, 0, 0, UTCLoc)
+    if !res.Equal(expect) {
+        t.Errorf("Expected '%s', parsed '%s'", expect, res)
+    }
+    
+    if len(skipped) != 2 || skipped[0].Text != "remind me on" || skipped[1].Text != "to call bob" {
+        t.Errorf("Unexpected skipped fragments %v", skipped)
+    }
+}
+
+func TestParseErrorSpan(t *testing.T) {
+    parser := &Parser{}
+    _, err := parser.Parse("1 2 3 4")
+    if err == nil {
+        t.Fatalf("Parse of \"1 2 3 4\" should fail, but did not.")
+    }
+    
+    perr, ok := err.(ParseError)
+    if !ok {
+        t.Fatalf("Expected a ParseError, got %T", err)
+    }
+    
+    if perr.Start != -1 || perr.End != -1 {
+        t.Errorf("Expected no location, got %d:%d", perr.Start, perr.End)
+    }
+    
+    _, err = parser.Parse("Sep 25 2003 10:36 foo")
+    perr, ok = err.(ParseError)
+    if !ok {
+        t.Fatalf("Expected a ParseError, got %v", err)
+    }
+    
+    if perr.Where != "foo" || perr.Start != 18 || perr.End != 21 {
+        t.Errorf("Expected failure at \"foo\" (18:21), got %q (%d:%d)", perr.Where, perr.Start, perr.End)
+    }
+}
+
+func TestParseErrorRepeatedToken(t *testing.T) {
+    parser := &Parser{}
+    _, err := parser.Parse("Sep 10 Sep")
+    perr, ok := err.(ParseError)
+    if !ok {
+        t.Fatalf("Expected a ParseError, got %v", err)
+    }
+    
+    if perr.Where != "Sep" || perr.Start != 7 || perr.End != 10 {
+        t.Errorf("Expected failure at the second \"Sep\" (7:10), got %q (%d:%d)", perr.Where, perr.Start, perr.End)
+    }
+}
+
+func TestParseErrorExcerpt(t *testing.T) {
+    parser := &Parser{ErrorExcerpts: true}
+    _, err := parser.Parse("10:36 on Thu bogus")
+    if err == nil {
+        t.Fatalf("Parse should fail, but did not.")
+    }
+    
+    expect := "Could not parse date \"10:36 on Thu bogus\": Unrecognised token (at \"bogus\")\n" +
+        "10:36 on Thu bogus\n" +
+        "             ^^^^^"
+    if err.Error() != expect {
+        t.Errorf("Expected error:\n%s\ngot:\n%s", expect, err.Error())
+    }
+}
+
+func TestParseErrorKinds(t *testing.T) {
+    parser := &Parser{}
+    cases := []struct {
+        timestr string
+        kind    error
+    }{
+        {"Sep 10 Sep", ErrMultipleMonths},
+        {"1 2 3 4", ErrTooManyDateComponents},
+        {"10:36 foo", ErrUnknownToken},
+        {"10:36 +12345", ErrBadTZOffset},
+        {"2003-foo", ErrExpectedMonth},
+        {"2003-09-foo", ErrBadNumber},
+    }
+    
+    for _, c := range cases {
+        _, err := parser.Parse(c.timestr)
+        if !errors.Is(err, c.kind) {
+            t.Errorf("Expected %q to fail with %v, got %v", c.timestr, c.kind, err)
+        }
+        
+        var perr ParseError
+        if !errors.As(err, &perr) || perr.Timestr != c.timestr {
+            t.Errorf("Expected %q to fail with a ParseError, got %v", c.timestr, err)
+        }
+    }
+}
+
+func TestParseErrorUnknownTimezone(t *testing.T) {
+    parser := &Parser{}
+    _, err := parser.Parse("10:36 XYZQ")
+    if !errors.Is(err, ErrUnknownTimezone) {
+        t.Fatalf("Expected ErrUnknownTimezone, got %v", err)
+    }
+    
+    var perr ParseError
+    if !errors.As(err, &perr) || perr.Where != "XYZQ" || perr.Start != 6 || perr.End != 10 {
+        t.Errorf("Expected a ParseError at \"XYZQ\" (6:10), got %#v", err)
+    }
+}
+
 func TestExtraSpace(t *testing.T) {
     parser := &Parser{}
     timestr := "  July   4 ,  1976   12:01:02   am  "
@@ -1029,6 +1177,798 @@ func TestSinceEpochNanoSeconds(t *testing.T) {
     check(t, parser, timestr, expect)
 }
 
+func TestRelativeYesterday(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "yesterday"
+    expect := time.Date(2003, 9, 24, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeTomorrowAt(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "tomorrow at 10:30"
+    expect := time.Date(2003, 9, 26, 10, 30, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeDaysAgo(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "3 days ago"
+    expect := time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeHoursAgo(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "2 hours ago"
+    expect := time.Date(2003, 9, 24, 22, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeInWeeks(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "in 2 weeks"
+    expect := time.Date(2003, 10, 9, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeFromNow(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "1.5 hours from now"
+    expect := time.Date(2003, 9, 25, 1, 30, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeNextWeekday(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "next thursday"
+    expect := time.Date(2003, 10, 2, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeLastWeekday(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "last friday"
+    expect := time.Date(2003, 9, 19, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeNextMonth(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "next month"
+    expect := time.Date(2003, 10, 25, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeLastYear(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    timestr := "last year"
+    expect := time.Date(2002, 9, 25, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestRelativeFractionalMonths(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    _, err := parser.Parse("in 1.5 months")
+    if !errors.Is(err, ErrBadNumber) {
+        t.Errorf("Expected ErrBadNumber, got %v", err)
+    }
+}
+
+func TestCustomVocabulary(t *testing.T) {
+    vocab := English
+    vocab.Jump = append([]string{"de"}, English.Jump...)
+    vocab.Months[8] = []string{"sep", "septiembre"}
+    vocab.Pertain = nil
+    
+    parser := &Parser{Info: NewParserInfo(vocab)}
+    timestr := "25 de Septiembre de 2003"
+    expect := time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+    
+    if _, err := (&Parser{}).Parse(timestr); err == nil {
+        t.Errorf("Default vocabulary should not be affected by a custom one")
+    }
+}
+
+func TestLocaleFrench(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(French)}
+    timestr := "jeudi 25 septembre 2003 à 10h49"
+    expect := time.Date(2003, 9, 25, 10, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocaleGerman(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(German)}
+    timestr := "Donnerstag, 25. September 2003 um 10:49 Uhr"
+    expect := time.Date(2003, 9, 25, 10, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocaleSpanish(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(Spanish)}
+    timestr := "jueves 25 de septiembre de 2003, 10:49 p. m."
+    expect := time.Date(2003, 9, 25, 22, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocaleItalian(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(Italian)}
+    timestr := "giovedì 25 settembre 2003 alle ore 10:49"
+    expect := time.Date(2003, 9, 25, 10, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocalePortuguese(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(Portuguese)}
+    timestr := "quinta-feira, 25 de setembro de 2003 às 10:49"
+    expect := time.Date(2003, 9, 25, 10, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocaleDutch(t *testing.T) {
+    parser := &Parser{Info: NewParserInfo(Dutch)}
+    timestr := "donderdag 25 september 2003 om 10:49 uur"
+    expect := time.Date(2003, 9, 25, 10, 49, 0, 0, UTCLoc)
+    check(t, parser, timestr, expect)
+}
+
+func TestLocaleAbbreviations(t *testing.T) {
+    cases := []struct {
+        vocab   Vocabulary
+        timestr string
+    }{
+        {French, "25 sept. 2003"},
+        {German, "25. Sept. 2003"},
+        {Spanish, "25-sep-2003"},
+        {Italian, "25 sett 2003"},
+        {Portuguese, "25/set/2003"},
+        {Dutch, "25 sep 2003"},
+    }
+    
+    expect := time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)
+    for _, c := range cases {
+        check(t, &Parser{Info: NewParserInfo(c.vocab)}, c.timestr, expect)
+    }
+}
+
+func TestLocaleRelative(t *testing.T) {
+    check(t, &Parser{Default: TestDefault, Info: NewParserInfo(German)}, "nächsten Freitag", time.Date(2003, 9, 26, 0, 0, 0, 0, UTCLoc))
+    check(t, &Parser{Default: TestDefault, Info: NewParserInfo(Dutch)}, "3 dagen geleden", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, &Parser{Default: TestDefault, Info: NewParserInfo(French)}, "demain", time.Date(2003, 9, 26, 0, 0, 0, 0, UTCLoc))
+}
+
+var TestLanguages = []*ParserInfo{
+    DefaultParserInfo,
+    NewParserInfo(French),
+    NewParserInfo(German),
+    NewParserInfo(Spanish),
+}
+
+func TestDetectLanguage(t *testing.T) {
+    parser := &Parser{Languages: TestLanguages}
+    cases := []struct {
+        timestr  string
+        language string
+    }{
+        {"Thu Sep 25 2003", "en"},
+        {"jeudi 25 septembre 2003", "fr"},
+        {"Donnerstag, 25. September 2003", "de"},
+        {"25 de septiembre de 2003", "es"},
+        {"2003-09-25", "en"},
+    }
+    
+    expect := time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc)
+    for _, c := range cases {
+        res, err := parser.ParseResult(c.timestr)
+        if err != nil {
+            t.Errorf("Parse failure for %q: %s", c.timestr, err.Error())
+            continue
+        }
+        
+        if res.Language != c.language {
+            t.Errorf("Expected %q to be detected as %q, got %q", c.timestr, c.language, res.Language)
+        }
+        
+        tm, err := res.Time(TestDefault)
+        if err != nil || !tm.Equal(expect) {
+            t.Errorf("Expected %q to parse as '%s', got '%s' (%v)", c.timestr, expect, tm, err)
+        }
+    }
+}
+
+func TestDetectLanguageMixed(t *testing.T) {
+    parser := &Parser{Languages: TestLanguages}
+    _, err := parser.Parse("jeudi 25 September 2003")
+    if !errors.Is(err, ErrMixedLanguages) {
+        t.Errorf("Expected ErrMixedLanguages, got %v", err)
+    }
+}
+
+func TestParseResultPartialDate(t *testing.T) {
+    parser := &Parser{}
+    res, err := parser.ParseResult("Sep 2003")
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    if !res.HasYear || res.Year != 2003 || !res.HasMonth || res.Month != time.September {
+        t.Errorf("Expected year 2003 and month September, got %+v", res)
+    }
+    
+    if res.HasDay || res.HasHour || res.HasMinute || res.HasSecond || res.HasWeekday || res.HasTZOffset {
+        t.Errorf("Expected only year and month to be present, got %+v", res)
+    }
+}
+
+func TestParseResultTwoDigitYear(t *testing.T) {
+    parser := &Parser{}
+    res, err := parser.ParseResult("31-Dec-00")
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    if !res.HasYear || res.Year != 2000 {
+        t.Errorf("Expected year 2000, got %d", res.Year)
+    }
+}
+
+func TestParseResultTime(t *testing.T) {
+    parser := &Parser{TZInfos: TestTZInfos}
+    res, err := parser.ParseResult("Thu Sep 10:36:28 BRST")
+    if err != nil {
+        t.Fatalf("Parse failure: %s", err.Error())
+    }
+    
+    if !res.HasHour || res.HasYear || res.HasDay || res.TZName != "BRST" {
+        t.Errorf("Unexpected result %+v", res)
+    }
+    
+    def := time.Date(2003, 9, 20, 0, 0, 0, 0, UTCLoc)
+    tm, err := res.Time(def)
+    if err != nil {
+        t.Fatalf("Time failure: %s", err.Error())
+    }
+    
+    expect := time.Date(2003, 9, 25, 10, 36, 28, 0, BRSTLoc)
+    if !tm.Equal(expect) {
+        t.Errorf("Expected '%s', got '%s'", expect, tm)
+    }
+}
+
+func TestWeekDateExtended(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-W39-4", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003-W39", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003-W39-4T10:49:41", time.Date(2003, 9, 25, 10, 49, 41, 0, UTCLoc))
+}
+
+func TestWeekDateBasic(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003W394", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003W39", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestWeekDateWords(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "week 39 2003", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "CW39 2003", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "CW39", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, &Parser{Default: TestDefault, Info: NewParserInfo(German)}, "KW 39 2003", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestWeekDateYearBoundaries(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2009-W01-1", time.Date(2008, 12, 29, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2004-W53-7", time.Date(2005, 1, 2, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestWeekDateInvalid(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    for _, timestr := range []string{"2003-W53", "2003-W54", "2003-W00", "2003-W39-8", "2003-W39-0"} {
+        _, err := parser.Parse(timestr)
+        if !errors.Is(err, ErrBadNumber) {
+            t.Errorf("Expected %q to fail with ErrBadNumber, got %v", timestr, err)
+        }
+    }
+}
+
+func TestOrdinalDate(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-268", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003268", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003-001", time.Date(2003, 1, 1, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestOrdinalDateWithTime(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-268T10:49:41", time.Date(2003, 9, 25, 10, 49, 41, 0, UTCLoc))
+    check(t, parser, "2003268T104941", time.Date(2003, 9, 25, 10, 49, 41, 0, UTCLoc))
+    check(t, &Parser{Default: TestDefault, TZInfos: TestTZInfos}, "2003-268 10:49:41 BRST", time.Date(2003, 9, 25, 10, 49, 41, 0, BRSTLoc))
+}
+
+func TestOrdinalDateLeapYear(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2004-366", time.Date(2004, 12, 31, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2004-060", time.Date(2004, 2, 29, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003-060", time.Date(2003, 3, 1, 0, 0, 0, 0, UTCLoc))
+    
+    for _, timestr := range []string{"2003-366", "2003366", "2003-000"} {
+        _, err := parser.Parse(timestr)
+        if !errors.Is(err, ErrBadNumber) {
+            t.Errorf("Expected %q to fail with ErrBadNumber, got %v", timestr, err)
+        }
+    }
+}
+
+func TestQuarter(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "Q3 2003", time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003Q3", time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003-Q4", time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "3Q03", time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Q2", time.Date(2003, 4, 1, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestHalf(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "H1 2003", time.Date(2003, 1, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "H2 2003", time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestFiscalYear(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "FY2004", time.Date(2004, 1, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "FY2004 Q2", time.Date(2004, 4, 1, 0, 0, 0, 0, UTCLoc))
+    
+    parser = &Parser{Default: TestDefault, FiscalYearStart: time.October}
+    check(t, parser, "FY2004", time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "FY04", time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "FY2004 Q1", time.Date(2003, 10, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Q2 FY2004", time.Date(2004, 1, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "FY2004 H2", time.Date(2004, 4, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Q3 2003", time.Date(2003, 7, 1, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestPeriodInvalid(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    for _, timestr := range []string{"Q5 2003", "H3 2003", "Q0", "Q1 Q2 2003"} {
+        _, err := parser.Parse(timestr)
+        if !errors.Is(err, ErrBadNumber) {
+            t.Errorf("Expected %q to fail with ErrBadNumber, got %v", timestr, err)
+        }
+    }
+    
+    _, err := parser.Parse("Q3 Sep 2003")
+    if !errors.Is(err, ErrTooManyDateComponents) {
+        t.Errorf("Expected a quarter with a month to fail with ErrTooManyDateComponents, got %v", err)
+    }
+}
+
+func TestEraBC(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "44 BC", time.Date(-43, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "753 BCE", time.Date(-752, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "15 March 44 BC", time.Date(-43, 3, 15, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Mar 15, 44 B.C.", time.Date(-43, 3, 15, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 BC", time.Date(0, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestEraAD(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "AD 800", time.Date(800, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "800 CE", time.Date(800, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Dec 800 A.D.", time.Date(800, 12, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestEraNoPivot(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "AD 9", time.Date(9, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Sep 9 AD 43", time.Date(43, 9, 9, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Mar 15, 9 BC", time.Date(-8, 3, 15, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestSignedISOYear(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "-0043-03-15", time.Date(-43, 3, 15, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "+12345-01-01", time.Date(12345, 1, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "+0043-03-15T10:00", time.Date(43, 3, 15, 10, 0, 0, 0, UTCLoc))
+    check(t, parser, "-0043", time.Date(-43, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "+2003", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestEraInvalid(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    _, err := parser.Parse("0 BC")
+    if !errors.Is(err, ErrBadNumber) {
+        t.Errorf("Expected year 0 BC to fail with ErrBadNumber, got %v", err)
+    }
+    
+    _, err = parser.Parse("44 BC AD")
+    if !errors.Is(err, ErrTooManyDateComponents) {
+        t.Errorf("Expected two eras to fail with ErrTooManyDateComponents, got %v", err)
+    }
+}
+
+func TestJulianCalendar(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Calendar: Julian}
+    check(t, parser, "5 October 1582", time.Date(1582, 10, 15, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "29 Feb 1700", time.Date(1700, 3, 11, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "15 March 44 BC", time.Date(-43, 3, 13, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Sep", time.Date(2003, 10, 8, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Sep 1700 N.S.", time.Date(1700, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestCalendarSwitchover(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Calendar: Switchover(time.Date(1752, 9, 14, 0, 0, 0, 0, UTCLoc))}
+    check(t, parser, "2 Sep 1752", time.Date(1752, 9, 13, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "14 Sep 1752", time.Date(1752, 9, 14, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "5 October 1582", time.Date(1582, 10, 15, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Sep 2003", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestCalendarMarkers(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "25 Sep 1700 O.S.", time.Date(1700, 10, 6, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Sep 1700 OS", time.Date(1700, 10, 6, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "11 Feb 1731 O.S. 10:00", time.Date(1731, 2, 22, 10, 0, 0, 0, UTCLoc))
+    check(t, parser, "25 Sep 1700 N.S.", time.Date(1700, 9, 25, 0, 0, 0, 0, UTCLoc))
+
+    res, err := parser.ParseResult("25 Sep 1700 O.S.")
+    if err != nil || res.Calendar != Julian || res.Day != 25 {
+        t.Errorf("Expected a Julian result for the 25th, got %v (%v)", res, err)
+    }
+
+    _, err = parser.Parse("25 Sep 1700 O.S. N.S.")
+    if !errors.Is(err, ErrTooManyDateComponents) {
+        t.Errorf("Expected two calendar markers to fail with ErrTooManyDateComponents, got %v", err)
+    }
+}
+
+func TestHijriCalendar(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Calendars: []*CalendarInfo{HijriInfo}}
+    check(t, parser, "1 Ramadan 1424 AH", time.Date(2003, 10, 27, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 Rabi' al-Awwal 1424", time.Date(2003, 5, 3, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Dhu'l-Hijjah 10, 1424 A.H.", time.Date(2004, 2, 2, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 Muharram 1", time.Date(622, 7, 19, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 Ramadan", time.Date(2003, 10, 27, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 Shawwal 1424 AH at 5pm", time.Date(2003, 11, 26, 17, 0, 0, 0, UTCLoc))
+
+    res, err := parser.ParseResult("1 Ramadan 1424 AH")
+    if err != nil || res.Calendar != Hijri || res.Year != 1424 || res.Month != 9 {
+        t.Errorf("Expected a Hijri result for Ramadan 1424, got %v (%v)", res, err)
+    }
+}
+
+func TestHebrewCalendar(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Calendars: []*CalendarInfo{HebrewInfo}}
+    check(t, parser, "25 Elul 5763", time.Date(2003, 9, 22, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "1 Tishrei 5764", time.Date(2003, 9, 27, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "14 Adar II 5763", time.Date(2003, 3, 18, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "14 Adar 5763", time.Date(2003, 2, 16, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "14 Adar 5764", time.Date(2004, 3, 7, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "15 Nisan", time.Date(2003, 4, 17, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestCalendarRoundTrip(t *testing.T) {
+    for _, cal := range []Calendar{Gregorian, Julian, Hijri, Hebrew} {
+        date := time.Date(1900, 1, 1, 0, 0, 0, 0, UTCLoc)
+        for i := 0; i < 400; i++ {
+            y, m, d := cal.ToGregorian(cal.FromGregorian(date.Date()))
+            if got := time.Date(y, m, d, 0, 0, 0, 0, UTCLoc); !got.Equal(date) {
+                t.Errorf("%v: %v converted back as %v", cal, date, got)
+            }
+            date = date.AddDate(0, 0, 97)
+        }
+    }
+}
+
+func TestCalendarMixed(t *testing.T) {
+    parser := &Parser{Default: TestDefault, Calendars: []*CalendarInfo{HijriInfo, HebrewInfo}}
+    for _, timestr := range []string{"1 Ramadan 1424 O.S.", "1 Ramadan 5763 25 Elul"} {
+        _, err := parser.Parse(timestr)
+        if err == nil {
+            t.Errorf("Expected %q to fail", timestr)
+        }
+    }
+}
+
+func TestJapaneseEra(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "平成15年9月25日", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Heisei 15", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Shōwa 64 Jan 7", time.Date(1989, 1, 7, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "令和元年5月1日", time.Date(2019, 5, 1, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Heisei gannen", time.Date(1989, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "明治5年12月2日", time.Date(1872, 12, 2, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestROCEra(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "民國92年", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "民國92年9月25日", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "ROC 1", time.Date(1912, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestBuddhistEra(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "25 Sep พ.ศ. 2546", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2546", time.Date(2546, 9, 25, 0, 0, 0, 0, UTCLoc))
+
+    parser = &Parser{Default: TestDefault, BuddhistEra: true, DayFirst: true}
+    check(t, parser, "2546", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25/09/2546", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "25/09/46", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "Heisei 15", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestCJKSuffixes(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003年9月25日", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003年9月25日 10:30", time.Date(2003, 9, 25, 10, 30, 0, 0, UTCLoc))
+    check(t, parser, "03年9月25日", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003년 9월 25일", time.Date(2003, 9, 25, 0, 0, 0, 0, UTCLoc))
+
+    parser = &Parser{Default: TestDefault, DayFirst: true}
+    check(t, parser, "3月10日", time.Date(2003, 3, 10, 0, 0, 0, 0, UTCLoc))
+    check(t, parser, "2003年3月10日", time.Date(2003, 3, 10, 0, 0, 0, 0, UTCLoc))
+}
+
+func TestTZAbbreviations(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "10:30 EST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+    check(t, parser, "10:30 CEST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("CEST", 2*3600)))
+    check(t, parser, "10:30 AEST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("AEST", 10*3600)))
+    check(t, parser, "10:30 NST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("NST", -3*3600-1800)))
+
+    // TZInfos takes precedence over the table.
+    check(t, &Parser{Default: TestDefault, TZInfos: TestTZInfos}, "10:30 BRST", time.Date(2003, 9, 25, 10, 30, 0, 0, BRSTLoc))
+}
+
+func TestTZAmbiguous(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "10:30 CST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("CST", -6*3600)))
+    check(t, parser, "10:30 IST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("IST", 5*3600+1800)))
+
+    parser = &Parser{Default: TestDefault, TZRegions: []string{"IE", "CN"}}
+    check(t, parser, "10:30 CST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("CST", 8*3600)))
+    check(t, parser, "10:30 IST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("IST", 3600)))
+
+    res, err := parser.ParseResult("10:30 CST")
+    if err != nil || !res.TZAmbiguous {
+        t.Errorf("Expected CST to be reported as ambiguous, got %v (%v)", res, err)
+    }
+
+    res, err = parser.ParseResult("10:30 EST")
+    if err != nil || res.TZAmbiguous {
+        t.Errorf("Expected EST not to be reported as ambiguous, got %v (%v)", res, err)
+    }
+
+    parser.TZResolver = TZOffsets{"CST": -6 * 3600}
+    res, err = parser.ParseResult("10:30 CST")
+    if err != nil || res.TZAmbiguous {
+        t.Errorf("Expected CST not to be reported as ambiguous with a resolver, got %v (%v)", res, err)
+    }
+
+    parser.TZResolver = TZOffsets{"EST": -5 * 3600}
+    res, err = parser.ParseResult("10:30 CST")
+    if err != nil || !res.TZAmbiguous {
+        t.Errorf("Expected CST to be reported as ambiguous with a resolver lacking it, got %v (%v)", res, err)
+    }
+}
+
+func TestTZResolver(t *testing.T) {
+    newYork, err := time.LoadLocation("America/New_York")
+    if err != nil {
+        t.Skipf("No timezone database: %v", err)
+    }
+
+    parser := &Parser{Default: TestDefault, TZResolver: TZLocations{"ET": newYork}}
+    check(t, parser, "10:30 ET", time.Date(2003, 9, 25, 10, 30, 0, 0, newYork))
+    check(t, parser, "Jan 15 10:30 ET", time.Date(2003, 1, 15, 10, 30, 0, 0, newYork))
+
+    res, _ := parser.Parse("Jan 15 10:30 ET")
+    if _, offset := res.Zone(); offset != -5*3600 {
+        t.Errorf("Expected ET in January to be UTC-5, got %d", offset)
+    }
+
+    // Names that the resolver does not know fall back to TZInfos and the
+    // abbreviation table.
+    parser.TZInfos = TestTZInfos
+    check(t, parser, "10:30 BRST", time.Date(2003, 9, 25, 10, 30, 0, 0, BRSTLoc))
+    check(t, parser, "10:30 EST", time.Date(2003, 9, 25, 10, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+}
+
+func TestTZResolverStrict(t *testing.T) {
+    strict := TZResolverFunc(func(name string, date time.Time) (*time.Location, error) {
+        loc, _ := TZOffsets(TestTZInfos).ResolveTZ(name, date)
+        if loc == nil {
+            return nil, fmt.Errorf("unknown timezone %q", name)
+        }
+        return loc, nil
+    })
+
+    parser := &Parser{Default: TestDefault, TZResolver: strict}
+    check(t, parser, "2003-09-25 10:36", time.Date(2003, 9, 25, 10, 36, 0, 0, UTCLoc))
+    check(t, parser, "2003-09-25 10:36 BRST", time.Date(2003, 9, 25, 10, 36, 0, 0, BRSTLoc))
+
+    _, err := parser.Parse("2003-09-25 10:36 EST")
+    if !errors.Is(err, ErrUnknownTimezone) {
+        t.Errorf("Expected a name the resolver rejects to fail with ErrUnknownTimezone, got %v", err)
+    }
+}
+
+func TestTZChain(t *testing.T) {
+    var dates []time.Time
+    record := TZResolverFunc(func(name string, date time.Time) (*time.Location, error) {
+        dates = append(dates, date)
+        return nil, nil
+    })
+
+    parser := &Parser{Default: TestDefault, TZResolver: TZChain{record, TZOffsets(TestTZInfos)}}
+    check(t, parser, "Sep 26 10:30 BRST", time.Date(2003, 9, 26, 10, 30, 0, 0, BRSTLoc))
+    if len(dates) != 1 || !dates[0].Equal(time.Date(2003, 9, 26, 10, 30, 0, 0, time.UTC)) {
+        t.Errorf("Expected the resolver to be given the date as written, got %v", dates)
+    }
+
+    failing := TZResolverFunc(func(name string, date time.Time) (*time.Location, error) {
+        return nil, errors.New("tenant lookup failed")
+    })
+    parser = &Parser{Default: TestDefault, TZResolver: TZChain{failing, TZOffsets(TestTZInfos)}}
+    _, err := parser.Parse("10:30 BRST")
+    if !errors.Is(err, ErrUnknownTimezone) {
+        t.Errorf("Expected a failing resolver to give ErrUnknownTimezone, got %v", err)
+    }
+}
+
+func TestZoneIDs(t *testing.T) {
+    paris, err := time.LoadLocation("Europe/Paris")
+    if err != nil {
+        t.Skipf("No timezone database: %v", err)
+    }
+    buenosAires, _ := time.LoadLocation("America/Argentina/Buenos_Aires")
+
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-09-25 10:36 Europe/Paris", time.Date(2003, 9, 25, 10, 36, 0, 0, paris))
+    check(t, parser, "Jan 15 10:36 Europe/Paris", time.Date(2003, 1, 15, 10, 36, 0, 0, paris))
+    check(t, parser, "10:36 America/Argentina/Buenos_Aires", time.Date(2003, 9, 25, 10, 36, 0, 0, buenosAires))
+    check(t, parser, "10:36 Etc/GMT+5", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -5*3600)))
+
+    _, err = parser.Parse("10:36 Europe/Nowhere")
+    if !errors.Is(err, ErrUnknownTimezone) {
+        t.Errorf("Expected an unknown zone to fail with ErrUnknownTimezone, got %v", err)
+    }
+}
+
+func TestZoneSuffix(t *testing.T) {
+    paris, err := time.LoadLocation("Europe/Paris")
+    if err != nil {
+        t.Skipf("No timezone database: %v", err)
+    }
+
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-09-25T10:36:28+02:00[Europe/Paris]", time.Date(2003, 9, 25, 10, 36, 28, 0, paris))
+    check(t, parser, "2003-09-25T10:36:28[Europe/Paris]", time.Date(2003, 9, 25, 10, 36, 28, 0, paris))
+    check(t, parser, "2003-09-25T08:36:28Z[Europe/Paris]", time.Date(2003, 9, 25, 10, 36, 28, 0, paris))
+    check(t, parser, "2003-09-25T10:36:28+02:00[Europe/Paris][u-ca=gregory]", time.Date(2003, 9, 25, 10, 36, 28, 0, paris))
+    check(t, parser, "2003-09-25T10:36:28+02:00[+02:00]", time.Date(2003, 9, 25, 10, 36, 28, 0, time.FixedZone("", 2*3600)))
+
+    res, err := parser.ParseResult("2003-09-25T10:36:28+02:00[Europe/Paris]")
+    if err != nil || res.Zone != "Europe/Paris" || res.TZOffset != 2*3600 {
+        t.Errorf("Expected zone Europe/Paris with offset +02:00, got %v (%v)", res, err)
+    }
+
+    _, err = parser.Parse("2003-09-25T10:36:28+02:00[Europe/Paris][!u-ca=gregory]")
+    if !errors.Is(err, ErrUnknownToken) {
+        t.Errorf("Expected a critical tag to fail with ErrUnknownToken, got %v", err)
+    }
+}
+
+func TestZoneMismatch(t *testing.T) {
+    paris, err := time.LoadLocation("Europe/Paris")
+    if err != nil {
+        t.Skipf("No timezone database: %v", err)
+    }
+
+    timestr := "2003-09-25T10:36:28+05:00[Europe/Paris]"
+
+    _, err = (&Parser{Default: TestDefault}).Parse(timestr)
+    if !errors.Is(err, ErrZoneMismatch) {
+        t.Errorf("Expected a mismatched zone to fail with ErrZoneMismatch, got %v", err)
+    }
+
+    check(t, &Parser{Default: TestDefault, ZoneMismatch: ZoneMismatchUseOffset}, timestr, time.Date(2003, 9, 25, 7, 36, 28, 0, paris))
+    check(t, &Parser{Default: TestDefault, ZoneMismatch: ZoneMismatchUseZone}, timestr, time.Date(2003, 9, 25, 10, 36, 28, 0, paris))
+
+    _, err = (&Parser{Default: TestDefault, ZoneMismatch: ZoneMismatchUseOffset}).Parse("2003-09-25T10:36:28+05:00[!Europe/Paris]")
+    if !errors.Is(err, ErrZoneMismatch) {
+        t.Errorf("Expected a mismatched critical zone to fail with ErrZoneMismatch, got %v", err)
+    }
+}
+
+func TestWindowsZones(t *testing.T) {
+    losAngeles, err := time.LoadLocation("America/Los_Angeles")
+    if err != nil {
+        t.Skipf("No timezone database: %v", err)
+    }
+    berlin, _ := time.LoadLocation("Europe/Berlin")
+    mexico, _ := time.LoadLocation("America/Mexico_City")
+
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-09-25 10:36:28 Pacific Standard Time", time.Date(2003, 9, 25, 10, 36, 28, 0, losAngeles))
+    check(t, parser, "2003-09-25 10:36:28 pacific daylight time", time.Date(2003, 9, 25, 10, 36, 28, 0, losAngeles))
+    check(t, parser, "2003-09-25 10:36:28 W. Europe Standard Time", time.Date(2003, 9, 25, 10, 36, 28, 0, berlin))
+    check(t, parser, "2003-09-25 10:36:28 Central Standard Time (Mexico)", time.Date(2003, 9, 25, 10, 36, 28, 0, mexico))
+    check(t, parser, "Thu Sep 25 10:36:28 2003 -0700 (Pacific Standard Time)", time.Date(2003, 9, 25, 10, 36, 28, 0, losAngeles))
+    check(t, parser, "Thu Sep 25 10:36:28 2003 +0200 (W. Europe Daylight Time)", time.Date(2003, 9, 25, 10, 36, 28, 0, berlin))
+
+    res, err := parser.ParseResult("2003-09-25 10:36:28 W. Europe Standard Time")
+    if err != nil || res.Zone != "Europe/Berlin" {
+        t.Errorf("Expected zone Europe/Berlin, got %v (%v)", res, err)
+    }
+
+    // A name in parentheses only labels the offset, so one that disagrees
+    // with it is not a mismatch.
+    check(t, parser, "Thu Sep 25 10:36:28 2003 -0300 (Pacific Standard Time)", time.Date(2003, 9, 25, 6, 36, 28, 0, losAngeles))
+
+    t1, err := parser.Parse("Thu Sep 25 10:36:28 2003 -0300 (Pacific Standard Time)")
+    if err != nil || t1.Location().String() != "America/Los_Angeles" {
+        t.Errorf("Expected the time in America/Los_Angeles, got %v (%v)", t1, err)
+    }
+}
+
+func TestExtendedOffsets(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "2003-09-25T10:36:28+05:30:15", time.Date(2003, 9, 25, 10, 36, 28, 0, time.FixedZone("", 5*3600+30*60+15)))
+    check(t, parser, "2003-09-25T10:36:28-053015", time.Date(2003, 9, 25, 10, 36, 28, 0, time.FixedZone("", -(5*3600+30*60+15))))
+    check(t, parser, "10:36 UTC+3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -3*3600)))
+    check(t, parser, "10:36 UTC+5.5", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -(5*3600+30*60))))
+    check(t, parser, "10:36 GMT+0530", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -(5*3600+30*60))))
+    check(t, parser, "10:36 Etc/GMT-3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 3*3600)))
+    check(t, parser, "2003-09-25T10:36:28[Etc/GMT-3]", time.Date(2003, 9, 25, 10, 36, 28, 0, time.FixedZone("", 3*3600)))
+
+    _, err := parser.Parse("10:36 UTC+25.5")
+    if !errors.Is(err, ErrBadTZOffset) {
+        t.Errorf("Expected an out of range offset to fail with ErrBadTZOffset, got %v", err)
+    }
+}
+
+func TestOffsetRange(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    check(t, parser, "10:36 +23:59:59", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 23*3600+59*60+59)))
+
+    for _, timestr := range []string{
+        "10:36 UTC+25", "10:36 +24", "10:36 +2400", "10:36 +0560", "10:36 +99:99", "10:36 +05:60",
+        "10:36 +05:30:60", "10:36 +243000", "10:36 +053060", "10:36 UTC+24.5",
+    } {
+        _, err := parser.ParseResult(timestr)
+        if !errors.Is(err, ErrBadTZOffset) {
+            t.Errorf("Expected %q to fail with ErrBadTZOffset, got %v", timestr, err)
+        }
+    }
+}
+
+func TestHumanUTCOffsets(t *testing.T) {
+    parser := &Parser{Default: TestDefault, HumanUTCOffsets: true}
+    check(t, parser, "10:36 UTC+3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 3*3600)))
+    check(t, parser, "10:36 UTC-3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", -3*3600)))
+    check(t, parser, "10:36 UTC+5.5", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 5*3600+30*60)))
+    check(t, parser, "10:36 UTC+5.75", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 5*3600+45*60)))
+    check(t, parser, "10:36 GMT+05:30", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 5*3600+30*60)))
+    check(t, parser, "10:36 BRST+3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("BRST", -3*3600)))
+    check(t, parser, "10:36 Etc/GMT-3", time.Date(2003, 9, 25, 10, 36, 0, 0, time.FixedZone("", 3*3600)))
+}
+
+func TestTrailingSeparators(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    for _, timestr := range []string{"10:36 +", "10:36 -03:", "2003-09-", "Sep-", "Sep-25-"} {
+        _, err := parser.ParseResult(timestr)
+        if _, ok := err.(ParseError); !ok {
+            t.Errorf("Expected %q to fail with a ParseError, got %v", timestr, err)
+        }
+    }
+}
+
 func testIncreasingInternal(t *testing.T, format string) {
     date := time.Date(1900, 1, 1, 0, 0, 0, 0, UTCLoc)
     
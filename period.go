@@ -0,0 +1,130 @@
+package dateparser
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	_PERIOD_NONE int = -1 + iota
+
+	_PERIOD_QUARTER
+	_PERIOD_HALF
+	_PERIOD_FISCAL
+)
+
+// Parses a period starting with the word at tokens[i]: a quarter or half such
+// as "Q3" or "H1", or a fiscal year such as "FY2004" or "FY 04". Returns the
+// index of the token following the period, or -1 if tokens[i] does not start
+// one. ok is false if the quarter or half is out of range, or if there is
+// already one in the result.
+func (res *parseresult) parsePeriodWord(info *ParserInfo, tokens []string, spans []span, ymd *[]int, i int) (next int, ok bool) {
+	numTokens := len(tokens)
+	period := info.period.search(tokens[i])
+
+	switch period {
+	case _PERIOD_QUARTER, _PERIOD_HALF:
+		if i+1 >= numTokens || len(tokens[i+1]) != 1 || !isDigits(tokens[i+1]) {
+			return -1, true
+		}
+
+		n, _ := strconv.Atoi(tokens[i+1])
+		res.periodSpan = span{spans[i].start, spans[i+1].end}
+		return i + 2, res.setPeriod(period, n)
+
+	case _PERIOD_FISCAL:
+		j := i + 1
+		if j < numTokens && tokens[j] == " " {
+			j++
+		}
+		if j >= numTokens || !isDigits(tokens[j]) || (len(tokens[j]) != 2 && len(tokens[j]) != 4) {
+			return -1, true
+		}
+
+		year, _ := strconv.Atoi(tokens[j])
+		*ymd = append(*ymd, year)
+		res.Fiscal = true
+		res.periodSpan = span{spans[i].start, spans[j].end}
+		return j + 1, true
+	}
+
+	return -1, true
+}
+
+// Sets the quarter or half of the result. Returns false if n is out of range
+// or the result already has a quarter or half.
+func (res *parseresult) setPeriod(period int, n int) (ok bool) {
+	if res.Quarter != -1 || res.Half != -1 {
+		return false
+	}
+
+	if period == _PERIOD_QUARTER {
+		res.Quarter = n
+		return n >= 1 && n <= 4
+	}
+
+	res.Half = n
+	return n >= 1 && n <= 2
+}
+
+// Returns whether the result is a quarter, half or fiscal year rather than a
+// single date.
+func (res Result) HasPeriod() (r bool) {
+	return res.HasQuarter || res.HasHalf || res.FiscalYear
+}
+
+// Returns the first month of the period described by the result, which is in
+// the year before res.Year for a fiscal year that does not start in January.
+func (res Result) periodStart(year int, fiscalYearStart time.Month) (startYear int, startMonth time.Month) {
+	startYear, startMonth = year, time.January
+	if res.FiscalYear && fiscalYearStart > time.January {
+		startYear, startMonth = year-1, fiscalYearStart
+	}
+
+	switch {
+	case res.HasQuarter:
+		startMonth += time.Month(3 * (res.Quarter - 1))
+	case res.HasHalf:
+		startMonth += time.Month(6 * (res.Half - 1))
+	}
+
+	if startMonth > time.December {
+		startYear++
+		startMonth -= 12
+	}
+
+	return startYear, startMonth
+}
+
+// Returns the length in months of the period described by the result.
+func (res Result) periodLength() (months int) {
+	switch {
+	case res.HasQuarter:
+		return 3
+	case res.HasHalf:
+		return 6
+	}
+
+	return 12
+}
+
+// Returns the interval covered by a period such as "Q3 2003" or "FY2004": the
+// start of the period and the start of the following one. As in Time, missing
+// components are taken from def. The error is a ParseError wrapping
+// ErrBadInterval if the result is not a period, or an error returned by Time.
+func (res Result) Period(def time.Time) (start time.Time, end time.Time, err error) {
+	if !res.HasPeriod() {
+		parser := res.parser
+		if parser == nil {
+			parser = defaultParser
+		}
+		return zeroTime, zeroTime, parser.errorAt(res.timestr, ErrBadInterval, "Not a quarter, half or fiscal year", res.timestr, span{0, len(res.timestr)})
+	}
+
+	start, err = res.Time(def)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	return start, start.AddDate(0, res.periodLength(), 0), nil
+}
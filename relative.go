@@ -0,0 +1,204 @@
+package dateparser
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	_UNIT_NONE int = -1 + iota
+
+	_UNIT_SECOND
+	_UNIT_MINUTE
+	_UNIT_HOUR
+	_UNIT_DAY
+	_UNIT_WEEK
+	_UNIT_MONTH
+	_UNIT_YEAR
+)
+
+const (
+	_RELATIVE_NONE int = -1 + iota
+
+	_RELATIVE_YESTERDAY
+	_RELATIVE_TODAY
+	_RELATIVE_TOMORROW
+	_RELATIVE_AGO
+	_RELATIVE_IN
+	_RELATIVE_FROM
+	_RELATIVE_NOW
+	_RELATIVE_NEXT
+	_RELATIVE_LAST
+)
+
+// Returns the unit named by s, which may also be one of the hour, minute or
+// second units used in times such as "10h36m".
+func unitOf(info *ParserInfo, s string) (unit int) {
+	unit = info.unit.search(s)
+	if unit != _UNIT_NONE {
+		return unit
+	}
+
+	switch info.hms.search(s) {
+	case _HMS_HOUR:
+		return _UNIT_HOUR
+	case _HMS_MINUTE:
+		return _UNIT_MINUTE
+	case _HMS_SECOND:
+		return _UNIT_SECOND
+	}
+
+	return _UNIT_NONE
+}
+
+// Looks for a unit at tokens[i], optionally preceded by a space. Returns the
+// unit and the index of the following token, or _UNIT_NONE.
+func unitAfter(info *ParserInfo, tokens []string, i int) (unit int, next int) {
+	j := i
+	if j < len(tokens) && tokens[j] == " " {
+		j++
+	}
+
+	if j < len(tokens) {
+		unit = unitOf(info, tokens[j])
+		if unit != _UNIT_NONE {
+			return unit, j + 1
+		}
+	}
+
+	return _UNIT_NONE, i
+}
+
+// Looks for the remainder of an expression of the form "<n> <unit> ago" or
+// "<n> <unit> from now", where i is the index of the token following the
+// number. Returns the unit, the direction of the offset and the index of the
+// token following the expression, or _UNIT_NONE.
+func relativeSuffixAt(info *ParserInfo, tokens []string, i int) (unit int, sign int, next int) {
+	numTokens := len(tokens)
+
+	unit, j := unitAfter(info, tokens, i)
+	if unit == _UNIT_NONE {
+		return _UNIT_NONE, 0, i
+	}
+
+	if j < numTokens && tokens[j] == " " {
+		j++
+	}
+
+	if j < numTokens {
+		switch info.relative.search(tokens[j]) {
+		case _RELATIVE_AGO:
+			return unit, -1, j + 1
+
+		case _RELATIVE_FROM:
+			if j+2 < numTokens && tokens[j+1] == " " && info.relative.search(tokens[j+2]) == _RELATIVE_NOW {
+				return unit, 1, j + 3
+			}
+		}
+	}
+
+	return _UNIT_NONE, 0, i
+}
+
+// Adds amount units to the relative offset of the result. Returns false if
+// the amount is fractional and the unit has no fixed length.
+func (res *parseresult) addRelative(unit int, amount float64) (ok bool) {
+	res.HasRelative = true
+
+	switch unit {
+	case _UNIT_SECOND:
+		res.RelDuration += time.Duration(amount * float64(time.Second))
+
+	case _UNIT_MINUTE:
+		res.RelDuration += time.Duration(amount * float64(time.Minute))
+
+	case _UNIT_HOUR:
+		res.RelDuration += time.Duration(amount * float64(time.Hour))
+
+	case _UNIT_DAY, _UNIT_WEEK:
+		if unit == _UNIT_WEEK {
+			amount *= 7
+		}
+		res.RelDays += int(amount)
+		res.RelDuration += time.Duration(getFractional(amount) * float64(24*time.Hour))
+
+	case _UNIT_MONTH, _UNIT_YEAR:
+		if hasFractional(amount) {
+			return false
+		}
+		if unit == _UNIT_YEAR {
+			res.RelYears += int(amount)
+		} else {
+			res.RelMonths += int(amount)
+		}
+	}
+
+	return true
+}
+
+// Parses a relative expression starting with the word at tokens[i], such as
+// "tomorrow", "in 3 days", "next friday" or "last month". Returns the index of
+// the token following the expression, or -1 if tokens[i] does not start a
+// relative expression. ok is false if the expression contained a fractional
+// number of months or years.
+func (res *parseresult) parseRelativeWord(info *ParserInfo, tokens []string, i int) (next int, ok bool) {
+	numTokens := len(tokens)
+	relative := info.relative.search(tokens[i])
+
+	switch relative {
+	case _RELATIVE_YESTERDAY, _RELATIVE_TODAY, _RELATIVE_TOMORROW:
+		res.HasRelative = true
+		res.RelDays += relative - _RELATIVE_TODAY
+		return i + 1, true
+
+	case _RELATIVE_IN:
+		j := i + 1
+		if j < numTokens && tokens[j] == " " {
+			j++
+		}
+		if j >= numTokens {
+			return -1, true
+		}
+
+		value, err := strconv.ParseFloat(tokens[j], 64)
+		if err != nil {
+			return -1, true
+		}
+
+		unit, next := unitAfter(info, tokens, j+1)
+		if unit == _UNIT_NONE {
+			return -1, true
+		}
+
+		return next, res.addRelative(unit, value)
+
+	case _RELATIVE_NEXT, _RELATIVE_LAST:
+		sign := 1
+		if relative == _RELATIVE_LAST {
+			sign = -1
+		}
+
+		j := i + 1
+		if j < numTokens && tokens[j] == " " {
+			j++
+		}
+		if j >= numTokens {
+			return -1, true
+		}
+
+		weekday := info.weekday.search(tokens[j])
+		if weekday != _WEEKDAY_NONE {
+			res.HasRelative = true
+			res.Weekday = weekday
+			res.WeekdayDirection = sign
+			return j + 1, true
+		}
+
+		unit := unitOf(info, tokens[j])
+		if unit != _UNIT_NONE {
+			return j + 1, res.addRelative(unit, float64(sign))
+		}
+	}
+
+	return -1, true
+}
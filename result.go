@@ -0,0 +1,443 @@
+package dateparser
+
+import (
+	"fmt"
+	"time"
+)
+
+// Contains the date/time components that were found in an input string,
+// before any missing components have been filled in from a default time. Each
+// component is only meaningful if the corresponding Has field is true; this
+// allows callers to distinguish between, for example, "Sep 2003" (which has no
+// day) and "1 Sep 2003".
+type Result struct {
+	Year       int          // The year, with two-digit years expanded and BC years in astronomical numbering (1 BC is 0).
+	Month      time.Month   // The month.
+	Day        int          // The day of the month.
+	Hour       int          // The hour, in 24-hour form.
+	Minute     int          // The minute.
+	Second     int          // The second.
+	Nanosecond int          // The fractional part of the second.
+	Weekday    time.Weekday // The day of the week.
+	TZName     string       // The timezone name, or "" if none was found.
+	TZOffset   int          // The timezone offset in seconds east of UTC.
+
+	// The timezone in brackets after the time (RFC 9557), as in
+	// "[Europe/Paris]", or the IANA timezone of a Windows timezone name such
+	// as "Pacific Standard Time", or "".
+	Zone string
+
+	Week    int // The ISO week number, in which case Year is the ISO week-numbering year.
+	Quarter int // The quarter of the year, from 1 to 4.
+	Half    int // The half of the year, 1 or 2.
+
+	HasYear       bool
+	HasMonth      bool
+	HasDay        bool
+	HasHour       bool
+	HasMinute     bool
+	HasSecond     bool
+	HasNanosecond bool
+	HasWeekday    bool
+	HasTZOffset   bool
+	HasWeek       bool
+	HasQuarter    bool
+	HasHalf       bool
+
+	// Whether Year is a fiscal year (see Parser.FiscalYearStart), as in
+	// "FY2004".
+	FiscalYear bool
+
+	// Whether TZName is an abbreviation that stands for more than one
+	// timezone (such as "CST" or "IST"), so that Time had to choose between
+	// them according to Parser.TZRegions. It is false when Parser.TZResolver
+	// resolves the name, as the resolver then decides what it stands for.
+	TZAmbiguous bool
+
+	// Relative offsets found in the input (from expressions such as
+	// "yesterday", "3 days ago" or "next month"). Time applies these after
+	// filling in the missing components from the default time.
+	RelYears    int
+	RelMonths   int
+	RelDays     int
+	RelDuration time.Duration
+
+	// Set to 1 by "next <weekday>" and -1 by "last <weekday>", in which case
+	// Weekday is resolved strictly after or before the default date instead
+	// of on or after it.
+	WeekdayDirection int
+
+	// Whether or not the input contained a relative expression.
+	HasRelative bool
+
+	// The language of the vocabulary that the input was parsed with (see
+	// ParserInfo.Language).
+	Language string
+
+	// The calendar in which the date is written: Julian or Gregorian if it
+	// was marked "O.S." or "N.S.", the calendar of a month name or era from
+	// Parser.Calendars, or else Parser.Calendar. Year, Month and
+	// Day are as written, and converted by Time.
+	Calendar Calendar
+
+	// The fragments of the input that were skipped, in the order they
+	// appeared. This is only filled in when parsing in fuzzy mode.
+	Skipped []Fragment
+
+	parser   *Parser
+	info     *ParserInfo
+	timestr  string
+	tzSpan   span
+	weekSpan span
+
+	zoneCritical bool
+	zoneLabel    bool
+	zoneSpan     span
+}
+
+// Returns the proleptic Gregorian date of the result, with missing components
+// taken from def. In a calendar other than the Gregorian one, def is first
+// converted to that calendar, so that "15 March" in a Julian parser is the
+// 15th of March of the current Julian year. ISO week dates and periods are
+// always Gregorian, and are resolved by Time.
+func (res Result) date(def time.Time) (year int, month time.Month, day int) {
+	cal := res.Calendar
+	if cal == nil || res.HasWeek || res.HasPeriod() {
+		cal = Gregorian
+	}
+
+	year, m, day := cal.FromGregorian(def.Date())
+	if res.HasYear {
+		year = res.Year
+	}
+	if res.HasMonth {
+		m = int(res.Month)
+	}
+	if res.HasDay {
+		day = res.Day
+	}
+
+	return cal.ToGregorian(year, m, day)
+}
+
+// Returns whether the month and day of the result are in range for its
+// calendar, so that time.Date does not move them into the following month or
+// year (as it would for "Sep 31" or "555-1234").
+func (res Result) validDate(def time.Time) (r bool) {
+	maxMonth := 12
+	if res.Calendar == Hebrew {
+		maxMonth = 13
+	}
+	if (res.HasMonth && (res.Month < 1 || int(res.Month) > maxMonth)) || (res.HasDay && (res.Day < 1 || res.Day > 31)) {
+		return false
+	}
+
+	if res.HasWeek || res.HasPeriod() || (res.Calendar != nil && res.Calendar != Gregorian) {
+		return true
+	}
+
+	year, month, day := res.date(def)
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return t.Month() == month && t.Day() == day
+}
+
+// Parses the input string and returns the components found in it, without
+// filling in missing components from parser.Default. Use the Time method of
+// the result to obtain a time.Time. The error may be a ParseError or an error
+// returned by bufio.Reader.ReadRune.
+func (parser *Parser) ParseResult(timestr string) (res Result, err error) {
+	pres, err := parser.parseInternal(timestr)
+	if err != nil {
+		return res, err
+	}
+
+	return parser.newResult(timestr, pres), nil
+}
+
+// Converts the components found by parseTokens into a Result.
+func (parser *Parser) newResult(timestr string, pres parseresult) (res Result) {
+	res = Result{
+		TZName:      pres.TZName,
+		TZOffset:    pres.TZOffset,
+		HasTZOffset: pres.HasTZOffset,
+		Zone:        pres.Zone,
+		Language:    pres.info.Language,
+		Skipped:     pres.Skipped,
+		Calendar:    parser.calendarFor(pres),
+
+		RelYears:         pres.RelYears,
+		RelMonths:        pres.RelMonths,
+		RelDays:          pres.RelDays,
+		RelDuration:      pres.RelDuration,
+		WeekdayDirection: pres.WeekdayDirection,
+		HasRelative:      pres.HasRelative,
+
+		parser:   parser,
+		info:     pres.info,
+		timestr:  timestr,
+		tzSpan:   pres.tzSpan,
+		weekSpan: pres.weekSpan,
+
+		zoneCritical: pres.zoneCritical,
+		zoneLabel:    pres.zoneLabel,
+		zoneSpan:     pres.zoneSpan,
+	}
+
+	if pres.explicitYear && (pres.Year != -1 || pres.yearIndex != -1) {
+		res.Year = pres.Year
+		res.HasYear = true
+	} else if pres.Year != -1 && parser.BuddhistEra {
+		res.Year = convertBuddhistYear(pres.Year)
+		res.HasYear = true
+	} else if pres.Year != -1 {
+		res.Year = convertYear(pres.Year)
+		res.HasYear = true
+	}
+	if pres.Month != -1 {
+		res.Month = time.Month(pres.Month)
+		res.HasMonth = true
+	}
+	if pres.Day != -1 {
+		res.Day = pres.Day
+		res.HasDay = true
+	}
+	if pres.Hour != -1 {
+		res.Hour = pres.Hour
+		res.HasHour = true
+	}
+	if pres.Minute != -1 {
+		res.Minute = pres.Minute
+		res.HasMinute = true
+	}
+	if pres.Second != -1 {
+		res.Second = pres.Second
+		res.HasSecond = true
+	}
+	if pres.Nanosecond != -1 {
+		res.Nanosecond = pres.Nanosecond
+		res.HasNanosecond = true
+	}
+	if pres.Weekday != -1 {
+		res.Weekday = time.Weekday(pres.Weekday)
+		res.HasWeekday = true
+	}
+	if pres.Week != -1 {
+		res.Week = pres.Week
+		res.HasWeek = true
+	}
+	if pres.Quarter != -1 {
+		res.Quarter = pres.Quarter
+		res.HasQuarter = true
+	}
+	if pres.Half != -1 {
+		res.Half = pres.Half
+		res.HasHalf = true
+	}
+	res.FiscalYear = pres.Fiscal
+
+	if res.TZName != "" && !res.HasTZOffset {
+		if _, ok := parser.TZInfos[res.TZName]; !ok {
+			_, res.TZAmbiguous, _ = parser.abbreviationOffset(res.TZName)
+			res.TZAmbiguous = res.TZAmbiguous && !parser.resolvesTZ(res)
+		}
+	}
+
+	return res
+}
+
+// Returns whether parser.TZResolver resolves the timezone name of res, so that
+// Time does not fall back to TZInfos or the table of abbreviations. The
+// resolver is asked about the date and time written in the input, with
+// missing components taken from the default time of Parse.
+func (parser *Parser) resolvesTZ(res Result) (r bool) {
+	if parser.TZResolver == nil {
+		return false
+	}
+
+	def := parser.defaultTime()
+	year, month, day := res.date(def)
+	hour, minute, second := def.Clock()
+	if res.HasHour {
+		hour = res.Hour
+	}
+	if res.HasMinute {
+		minute = res.Minute
+	}
+	if res.HasSecond {
+		second = res.Second
+	}
+
+	written := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	loc, err := parser.TZResolver.ResolveTZ(res.TZName, written)
+	return loc != nil || err != nil
+}
+
+// Parses the input string in fuzzy mode (regardless of the value of
+// parser.Fuzzy) and returns the parsed date along with the fragments of the
+// input that were skipped, in the order they appeared. The error may be a
+// ParseError or an error returned by bufio.Reader.ReadRune.
+func (parser *Parser) ParseFuzzyWithTokens(timestr string) (t time.Time, skipped []Fragment, err error) {
+	fuzzy := *parser
+	fuzzy.Fuzzy = true
+
+	res, err := fuzzy.ParseResult(timestr)
+	if err != nil {
+		return zeroTime, nil, err
+	}
+
+	t, err = res.Time(parser.defaultTime())
+	if err != nil {
+		return zeroTime, nil, err
+	}
+
+	return t, res.Skipped, nil
+}
+
+// Converts the result into a time.Time, taking any components not present in
+// the input from def. The settings of the Parser that produced the result
+// (such as IgnoreTZ and TZInfos) are used to resolve the timezone. The error
+// is a ParseError wrapping ErrUnknownTimezone (and the error returned by
+// time.LoadLocation) if the timezone name could not be resolved.
+func (res Result) Time(def time.Time) (t time.Time, err error) {
+	parser := res.parser
+	if parser == nil {
+		parser = defaultParser
+	}
+	info := res.info
+	if info == nil {
+		info = parser.info()
+	}
+
+	tzName := res.TZName
+	tzOffset := res.TZOffset
+
+	if tzOffset == 0 && (tzName == "" || tzName == "Z") {
+		tzName = "UTC"
+	} else if tzOffset != 0 && tzName != "" && info.utczone.search(tzName) != _UTCZONE_NONE {
+		tzOffset = 0
+	}
+
+	year, month, day := res.date(def)
+	if res.HasWeek {
+		if !res.HasYear {
+			year, _ = def.ISOWeek()
+		}
+		if res.Week > isoWeeksIn(year) {
+			where := res.timestr[res.weekSpan.start:res.weekSpan.end]
+			return zeroTime, parser.errorAt(res.timestr, ErrBadNumber, "Week out of range for year", where, res.weekSpan)
+		}
+
+		weekday := time.Monday
+		if res.HasWeekday {
+			weekday = res.Weekday
+		}
+		year, month, day = isoWeekDate(year, res.Week, weekday)
+	}
+
+	if res.HasPeriod() {
+		year, month = res.periodStart(year, parser.FiscalYearStart)
+		day = 1
+	}
+
+	hour := def.Hour()
+	if res.HasHour {
+		hour = res.Hour
+	}
+	minute := def.Minute()
+	if res.HasMinute {
+		minute = res.Minute
+	}
+	second := def.Second()
+	if res.HasSecond {
+		second = res.Second
+	}
+	nanosecond := def.Nanosecond()
+	if res.HasNanosecond {
+		nanosecond = res.Nanosecond
+	}
+
+	loc := def.Location()
+
+	if parser.IgnoreTZ {
+		loc = time.FixedZone("UTC", 0)
+
+	} else {
+		if tzName != "" {
+			if res.HasTZOffset {
+				loc = time.FixedZone(tzName, tzOffset)
+
+			} else {
+				// Zoneless input is in UTC without asking the resolver, which
+				// only resolves names found in the input.
+				ok := false
+				if parser.TZResolver != nil && res.TZName != "" {
+					written := time.Date(year, month, day, hour, minute, second, nanosecond, time.UTC)
+					loc, err = parser.TZResolver.ResolveTZ(tzName, written)
+					if err != nil {
+						perr := parser.errorAt(res.timestr, ErrUnknownTimezone, "Could not resolve timezone", res.TZName, res.tzSpan)
+						perr.Err = fmt.Errorf("%w: %w", ErrUnknownTimezone, err)
+						return zeroTime, perr
+					}
+					ok = loc != nil
+				}
+
+				if !ok && parser.TZInfos != nil {
+					var offset int
+					offset, ok = parser.TZInfos[tzName]
+					if ok {
+						loc = time.FixedZone(tzName, offset)
+					}
+				}
+
+				if !ok {
+					var offset int
+					offset, _, ok = parser.abbreviationOffset(tzName)
+					if ok {
+						loc = time.FixedZone(tzName, offset)
+					}
+				}
+
+				if !ok {
+					loc, err = time.LoadLocation(tzName)
+					if err != nil {
+						perr := parser.errorAt(res.timestr, ErrUnknownTimezone, "Unknown timezone", res.TZName, res.tzSpan)
+						perr.Err = fmt.Errorf("%w: %w", ErrUnknownTimezone, err)
+						return zeroTime, perr
+					}
+				}
+			}
+
+		} else if res.HasTZOffset {
+			loc = time.FixedZone("", tzOffset)
+		}
+	}
+
+	t = time.Date(year, month, day, hour, minute, second, nanosecond, loc)
+
+	if res.Zone != "" && !parser.IgnoreTZ {
+		hasInstant := res.TZName != "" || res.HasTZOffset
+		// A Windows name in parentheses after the offset only labels it, so
+		// the instant is shown in that timezone even if the offsets differ.
+		hasOffset := hasInstant && info.utczone.search(res.TZName) == _UTCZONE_NONE && !res.zoneLabel
+		t, err = res.inZone(parser, t, hasInstant, hasOffset)
+		if err != nil {
+			return zeroTime, err
+		}
+	}
+
+	if res.HasWeekday && !res.HasDay && !res.HasWeek {
+		weekdayOffset := (int(res.Weekday) - int(t.Weekday()) + 7) % 7
+		if res.WeekdayDirection > 0 && weekdayOffset == 0 {
+			weekdayOffset = 7
+		} else if res.WeekdayDirection < 0 {
+			weekdayOffset -= 7
+		}
+		t = t.Add(time.Duration(weekdayOffset*24) * time.Hour)
+	}
+
+	if res.HasRelative {
+		t = t.AddDate(res.RelYears, res.RelMonths, res.RelDays).Add(res.RelDuration)
+	}
+
+	return t, nil
+}
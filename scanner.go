@@ -0,0 +1,178 @@
+package dateparser
+
+import (
+	"io"
+	"time"
+)
+
+// The number of tokens kept after the start of a possible match, so that the
+// end of the longest match and the sentence break after it can be found.
+const _SCANNER_WINDOW = _EXTRACT_MAX_TOKENS + 8
+
+// Records the bytes read from a reader, so that the text of the tokens can be
+// recovered.
+type recorder struct {
+	reader io.Reader
+	buf    []byte
+}
+
+func (rec *recorder) Read(p []byte) (n int, err error) {
+	n, err = rec.reader.Read(p)
+	rec.buf = append(rec.buf, p[:n]...)
+	return n, err
+}
+
+// Reads text from an io.Reader and finds the dates in it, in the same way as
+// Parser.Extract, one at a time. Only a bounded window of the input is kept in
+// memory, so a Scanner can be used on inputs of any size:
+//
+//	scanner := parser.NewScanner(file)
+//	for scanner.Scan() {
+//		m := scanner.Match()
+//		fmt.Println(m.Start, m.Text, m.Time)
+//	}
+//	if err := scanner.Err(); err != nil {
+//		// Reading the file failed.
+//	}
+//
+// Text that does not parse as a date is skipped rather than reported, so the
+// only errors are those returned by the reader.
+type Scanner struct {
+	parser *Parser // The parser that created the scanner.
+	strict *Parser // A copy of parser with Fuzzy set to false.
+	infos  []*ParserInfo
+	def    time.Time
+
+	lex  *lexer
+	rec  *recorder
+	base int // The byte offset in the input of rec.buf[0].
+
+	tokens []string
+	spans  []span // Byte offsets in the input.
+	done   bool
+
+	match Match
+	err   error
+}
+
+// Returns a Scanner that finds the dates in the text read from r.
+func (parser *Parser) NewScanner(r io.Reader) (s *Scanner) {
+	strict := *parser
+	strict.Fuzzy = false
+
+	rec := &recorder{reader: r}
+
+	return &Scanner{
+		parser: parser,
+		strict: &strict,
+		infos:  strict.vocabularies(),
+		def:    strict.defaultTime(),
+		lex:    newLexer(rec),
+		rec:    rec,
+	}
+}
+
+// Returns a Scanner that finds the dates in the text read from r using a parser
+// with all values at their defaults.
+func NewScanner(r io.Reader) (s *Scanner) {
+	return defaultParser.NewScanner(r)
+}
+
+// Reads tokens until the window is full or the input ends.
+func (s *Scanner) fill() {
+	for !s.done && len(s.tokens) < _SCANNER_WINDOW {
+		token, sp, err := s.lex.lex()
+		if err != nil {
+			s.err = err
+			s.done = true
+			break
+		}
+
+		if token == "" {
+			s.done = true
+			break
+		}
+
+		s.tokens = append(s.tokens, token)
+		s.spans = append(s.spans, sp)
+	}
+}
+
+// Removes the first n tokens from the window, along with the text before the
+// token that follows them.
+func (s *Scanner) drop(n int) {
+	newBase := s.spans[n-1].end
+	if n < len(s.tokens) {
+		newBase = s.spans[n].start
+	}
+
+	s.tokens = s.tokens[n:]
+	s.spans = s.spans[n:]
+	s.rec.buf = s.rec.buf[newBase-s.base:]
+	s.base = newBase
+}
+
+// Returns res as though it had been parsed from text, which starts at byte
+// offset start of the input it was parsed from.
+func (res Result) rebase(text string, start int) Result {
+	res.timestr = text
+	for _, sp := range []*span{&res.tzSpan, &res.weekSpan, &res.zoneSpan} {
+		if sp.start >= start {
+			sp.start -= start
+			sp.end -= start
+		}
+	}
+
+	return res
+}
+
+// Advances to the next date in the input, which is then available through the
+// Match method. Returns false when the input ends or the reader returns an
+// error; Err distinguishes between the two.
+//
+// As in Extract, up to 40 runs of tokens are parsed at each token that could
+// start a date, so text dense with numbers and month or weekday names is
+// slower to scan than ordinary prose.
+func (s *Scanner) Scan() (ok bool) {
+	for {
+		s.fill()
+		if len(s.tokens) == 0 {
+			return false
+		}
+
+		end := s.spans[len(s.spans)-1].end
+		text := string(s.rec.buf[:end-s.base])
+
+		spans := make([]span, len(s.spans))
+		for i, sp := range s.spans {
+			spans[i] = span{sp.start - s.base, sp.end - s.base}
+		}
+
+		breaks := sentenceBreaks(s.infos, text, s.tokens, spans)
+		m, next := s.strict.matchAt(s.infos, s.def, text, s.tokens, spans, breaks, 0)
+		if next == -1 {
+			s.drop(1)
+			continue
+		}
+
+		m.Result = m.Result.rebase(m.Text, m.Start)
+		m.Result.parser = s.parser
+		m.Start += s.base
+		m.End += s.base
+		s.match = m
+		s.drop(next)
+		return true
+	}
+}
+
+// Returns the date found by the last call to Scan, with Start and End giving
+// its byte offsets in the whole input. As the input is not kept, errors from
+// the Time method of its Result refer to Text alone.
+func (s *Scanner) Match() (m Match) {
+	return s.match
+}
+
+// Returns the first error returned by the reader, other than io.EOF.
+func (s *Scanner) Err() (err error) {
+	return s.err
+}
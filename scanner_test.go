@@ -0,0 +1,122 @@
+package dateparser
+
+import (
+    "errors"
+    "strings"
+    "testing"
+    "testing/iotest"
+    "time"
+)
+
+var TestScannerText = "Hi Bob, can we meet on Friday at 10am or 3pm? The report from " +
+    "2003-09-25 is due Sep. 30, 2003. You may call me in 3 days.\n" +
+    "Released on 2003-09-25 at 10:49:41; see you then. "
+
+func scanAll(s *Scanner) (matches []Match) {
+    for s.Scan() {
+        matches = append(matches, s.Match())
+    }
+    
+    return matches
+}
+
+func TestScannerMatchesExtract(t *testing.T) {
+    parser := &Parser{Default: TestDefault}
+    text := strings.Repeat(TestScannerText, 20)
+    
+    expect, err := parser.Extract(text)
+    if err != nil {
+        t.Fatalf("Extract failure: %s", err.Error())
+    }
+    
+    matches := scanAll(parser.NewScanner(iotest.OneByteReader(strings.NewReader(text))))
+    if len(matches) != len(expect) {
+        t.Fatalf("Expected %d matches, got %d", len(expect), len(matches))
+    }
+    
+    for i, m := range matches {
+        if m.Fragment != expect[i].Fragment || !m.Time.Equal(expect[i].Time) {
+            t.Errorf("Expected match %d to be %v at %s, got %v at %s", i, expect[i].Fragment, expect[i].Time, m.Fragment, m.Time)
+        }
+        if text[m.Start:m.End] != m.Text {
+            t.Errorf("Match %v does not have the offsets of its text", m.Fragment)
+        }
+    }
+}
+
+func TestScannerTrailingSeparators(t *testing.T) {
+    text := "Meeting at 10:36 + snacks. Temperature was 10:36 -03: cold. Due Sep-25- or so.\n"
+    s := (&Parser{Default: TestDefault}).NewScanner(iotest.OneByteReader(strings.NewReader(strings.Repeat(text, 5))))
+    
+    matches := scanAll(s)
+    if s.Err() != nil || len(matches) != 15 {
+        t.Fatalf("Expected 15 matches and no error, got %d (%v)", len(matches), s.Err())
+    }
+    
+    for i, expect := range []string{"10:36", "10:36 -03", "Sep-25"} {
+        if matches[i].Text != expect {
+            t.Errorf("Expected match %d to be %q, got %q", i, expect, matches[i].Text)
+        }
+    }
+}
+
+func TestScannerEmpty(t *testing.T) {
+    s := NewScanner(strings.NewReader(""))
+    if s.Scan() || s.Err() != nil {
+        t.Errorf("Expected no matches and no error, got %v", s.Err())
+    }
+}
+
+func TestScannerReaderError(t *testing.T) {
+    failure := errors.New("disk on fire")
+    reader := &errorAfterReader{strings.NewReader("Released on 2003-09-25 at 10:49:41; and then "), failure}
+    s := NewScanner(reader)
+    
+    matches := scanAll(s)
+    if len(matches) != 1 || matches[0].Text != "2003-09-25 at 10:49:41" {
+        t.Errorf("Expected the match before the error, got %v", matches)
+    }
+    if !errors.Is(s.Err(), failure) {
+        t.Errorf("Expected the reader's error, got %v", s.Err())
+    }
+}
+
+// Returns the contents of a reader followed by an error.
+type errorAfterReader struct {
+    r   *strings.Reader
+    err error
+}
+
+func (r *errorAfterReader) Read(p []byte) (n int, err error) {
+    n, err = r.r.Read(p)
+    if err != nil {
+        err = r.err
+    }
+    
+    return n, err
+}
+
+func TestScannerResultError(t *testing.T) {
+    resolver := TZResolverFunc(func(name string, date time.Time) (*time.Location, error) {
+        if date.Year() != 2003 {
+            return nil, errors.New("no rules for that year")
+        }
+        return time.FixedZone(name, -5*3600), nil
+    })
+    parser := &Parser{Default: TestDefault, TZResolver: resolver}
+    
+    s := parser.NewScanner(strings.NewReader(strings.Repeat("Filler text. ", 20) + "Call at 10:30 ET please."))
+    if !s.Scan() {
+        t.Fatalf("Expected a match, got none (%v)", s.Err())
+    }
+    
+    m := s.Match()
+    _, err := m.Result.Time(time.Date(2004, 1, 1, 0, 0, 0, 0, time.UTC))
+    var perr ParseError
+    if !errors.As(err, &perr) {
+        t.Fatalf("Expected a ParseError, got %v", err)
+    }
+    if perr.Timestr != m.Text || perr.Timestr[perr.Start:perr.End] != "ET" {
+        t.Errorf("Expected the error to point at ET in %q, got %q [%d:%d]", m.Text, perr.Timestr, perr.Start, perr.End)
+    }
+}
@@ -0,0 +1,106 @@
+package dateparser
+
+// A timezone that an abbreviation may stand for.
+type tzAbbreviation struct {
+	offset  int      // The offset in seconds east of UTC.
+	regions []string // The ISO 3166 codes of the countries that use it.
+}
+
+// Abbreviations of commonly used timezones. Where an abbreviation stands for
+// more than one timezone, the first is used unless Parser.TZRegions prefers
+// another.
+var tzAbbreviations = map[string][]tzAbbreviation{
+	// North America
+	"EST":  {{-5 * 3600, []string{"US", "CA"}}},
+	"EDT":  {{-4 * 3600, []string{"US", "CA"}}},
+	"CST":  {{-6 * 3600, []string{"US", "CA", "MX"}}, {8 * 3600, []string{"CN", "TW"}}, {-5 * 3600, []string{"CU"}}},
+	"CDT":  {{-5 * 3600, []string{"US", "CA", "MX"}}, {-4 * 3600, []string{"CU"}}},
+	"MST":  {{-7 * 3600, []string{"US", "CA", "MX"}}},
+	"MDT":  {{-6 * 3600, []string{"US", "CA", "MX"}}},
+	"PST":  {{-8 * 3600, []string{"US", "CA", "MX"}}, {8 * 3600, []string{"PH"}}},
+	"PDT":  {{-7 * 3600, []string{"US", "CA", "MX"}}},
+	"AKST": {{-9 * 3600, []string{"US"}}},
+	"AKDT": {{-8 * 3600, []string{"US"}}},
+	"HST":  {{-10 * 3600, []string{"US"}}},
+	"HDT":  {{-9 * 3600, []string{"US"}}},
+	"AST":  {{-4 * 3600, []string{"CA", "PR", "US"}}, {3 * 3600, []string{"SA", "IQ", "KW", "QA", "BH", "YE"}}},
+	"ADT":  {{-3 * 3600, []string{"CA"}}},
+	"NST":  {{-3*3600 - 1800, []string{"CA"}}},
+	"NDT":  {{-2*3600 - 1800, []string{"CA"}}},
+
+	// South America
+	"BRT":  {{-3 * 3600, []string{"BR"}}},
+	"BRST": {{-2 * 3600, []string{"BR"}}},
+	"ART":  {{-3 * 3600, []string{"AR"}}},
+	"CLT":  {{-4 * 3600, []string{"CL"}}},
+	"CLST": {{-3 * 3600, []string{"CL"}}},
+
+	// Europe
+	"WET":  {{0, []string{"PT"}}},
+	"WEST": {{1 * 3600, []string{"PT"}}},
+	"BST":  {{1 * 3600, []string{"GB"}}, {6 * 3600, []string{"BD"}}},
+	"IST":  {{5*3600 + 1800, []string{"IN"}}, {1 * 3600, []string{"IE"}}, {2 * 3600, []string{"IL"}}},
+	"CET":  {{1 * 3600, []string{"DE", "FR", "IT", "ES", "NL", "BE", "AT", "CH", "PL", "SE", "NO", "DK", "CZ", "HU"}}},
+	"CEST": {{2 * 3600, []string{"DE", "FR", "IT", "ES", "NL", "BE", "AT", "CH", "PL", "SE", "NO", "DK", "CZ", "HU"}}},
+	"MET":  {{1 * 3600, []string{"DE"}}},
+	"MEST": {{2 * 3600, []string{"DE"}}},
+	"EET":  {{2 * 3600, []string{"FI", "GR", "RO", "BG", "UA", "EG"}}},
+	"EEST": {{3 * 3600, []string{"FI", "GR", "RO", "BG", "UA"}}},
+	"MSK":  {{3 * 3600, []string{"RU"}}},
+
+	// Africa and the Middle East
+	"WAT":  {{1 * 3600, []string{"NG"}}},
+	"CAT":  {{2 * 3600, []string{"ZA", "ZW", "ZM", "MZ"}}},
+	"EAT":  {{3 * 3600, []string{"KE", "TZ", "UG", "ET"}}},
+	"SAST": {{2 * 3600, []string{"ZA"}}},
+	"IDT":  {{3 * 3600, []string{"IL"}}},
+	"GST":  {{4 * 3600, []string{"AE", "OM"}}},
+
+	// Asia
+	"PKT":  {{5 * 3600, []string{"PK"}}},
+	"NPT":  {{5*3600 + 2700, []string{"NP"}}},
+	"ICT":  {{7 * 3600, []string{"TH", "VN", "KH", "LA"}}},
+	"WIB":  {{7 * 3600, []string{"ID"}}},
+	"WITA": {{8 * 3600, []string{"ID"}}},
+	"WIT":  {{9 * 3600, []string{"ID"}}},
+	"SGT":  {{8 * 3600, []string{"SG"}}},
+	"MYT":  {{8 * 3600, []string{"MY"}}},
+	"PHT":  {{8 * 3600, []string{"PH"}}},
+	"HKT":  {{8 * 3600, []string{"HK"}}},
+	"JST":  {{9 * 3600, []string{"JP"}}},
+	"KST":  {{9 * 3600, []string{"KR"}}},
+
+	// Oceania
+	"AWST": {{8 * 3600, []string{"AU"}}},
+	"ACST": {{9*3600 + 1800, []string{"AU"}}},
+	"ACDT": {{10*3600 + 1800, []string{"AU"}}},
+	"AEST": {{10 * 3600, []string{"AU"}}},
+	"AEDT": {{11 * 3600, []string{"AU"}}},
+	"NZST": {{12 * 3600, []string{"NZ"}}},
+	"NZDT": {{13 * 3600, []string{"NZ"}}},
+}
+
+// Returns the offset in seconds east of UTC of the timezone abbreviation name,
+// choosing between the timezones it may stand for according to
+// parser.TZRegions. ambiguous is true if it may stand for more than one, and
+// ok is false if it is not a known abbreviation.
+func (parser *Parser) abbreviationOffset(name string) (offset int, ambiguous bool, ok bool) {
+	candidates := tzAbbreviations[name]
+	if len(candidates) == 0 {
+		return 0, false, false
+	}
+
+	ambiguous = len(candidates) > 1
+
+	for _, region := range parser.TZRegions {
+		for _, candidate := range candidates {
+			for _, r := range candidate.regions {
+				if r == region {
+					return candidate.offset, ambiguous, true
+				}
+			}
+		}
+	}
+
+	return candidates[0].offset, ambiguous, true
+}
@@ -0,0 +1,68 @@
+package dateparser
+
+import (
+	"time"
+)
+
+// Resolves the timezone names found in the input (such as "ET" or "CST") to
+// locations, which may observe daylight saving time. Set Parser.TZResolver to
+// use one.
+type TZResolver interface {
+	// Returns the location named name. date is the date and time written in
+	// the input (with missing components taken from the default time), in
+	// UTC, so that a resolver may choose a location by date. Returns a nil
+	// location and a nil error if the name is not known, in which case the
+	// parser falls back to its other ways of resolving it; a non-nil error
+	// makes parsing fail.
+	ResolveTZ(name string, date time.Time) (loc *time.Location, err error)
+}
+
+// Adapts an ordinary function to a TZResolver.
+type TZResolverFunc func(name string, date time.Time) (loc *time.Location, err error)
+
+// Returns f(name, date).
+func (f TZResolverFunc) ResolveTZ(name string, date time.Time) (loc *time.Location, err error) {
+	return f(name, date)
+}
+
+// A TZResolver that maps timezone names to fixed offsets in seconds east of
+// UTC, as in Parser.TZInfos.
+type TZOffsets map[string]int
+
+// Returns a location with the fixed offset of name, or nil if it is not in
+// the map.
+func (offsets TZOffsets) ResolveTZ(name string, date time.Time) (loc *time.Location, err error) {
+	offset, ok := offsets[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return time.FixedZone(name, offset), nil
+}
+
+// A TZResolver that maps timezone names to locations, as in
+//
+//	TZLocations{"ET": newYork, "PT": losAngeles}
+//
+// where newYork and losAngeles were returned by time.LoadLocation.
+type TZLocations map[string]*time.Location
+
+// Returns the location of name, or nil if it is not in the map.
+func (locations TZLocations) ResolveTZ(name string, date time.Time) (loc *time.Location, err error) {
+	return locations[name], nil
+}
+
+// A TZResolver that tries each of its resolvers in turn.
+type TZChain []TZResolver
+
+// Returns the first location or error returned by the resolvers in the chain.
+func (chain TZChain) ResolveTZ(name string, date time.Time) (loc *time.Location, err error) {
+	for _, resolver := range chain {
+		loc, err = resolver.ResolveTZ(name, date)
+		if loc != nil || err != nil {
+			return loc, err
+		}
+	}
+
+	return nil, nil
+}
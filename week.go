@@ -0,0 +1,95 @@
+package dateparser
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	_WEEK_NONE int = -1 + iota
+
+	_WEEK
+)
+
+// Returns whether s is non-empty and made up only of the digits 0-9.
+func isDigits(s string) (r bool) {
+	for _, char := range s {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Parses an ISO week number starting with the word at tokens[i], such as
+// "W39", "W39-4", "W394", "week 39" or "CW39", where the optional last digit
+// is the day of the week from 1 (Monday) to 7 (Sunday). Returns the index of
+// the token following the week number, or -1 if tokens[i] does not start one.
+// ok is false if the week or day is out of range.
+func (res *parseresult) parseWeek(info *ParserInfo, tokens []string, spans []span, i int) (next int, ok bool) {
+	numTokens := len(tokens)
+
+	if info.week.search(tokens[i]) == _WEEK_NONE {
+		return -1, true
+	}
+
+	j := i + 1
+	if j < numTokens && tokens[j] == " " {
+		j++
+	}
+	if j >= numTokens || !isDigits(tokens[j]) {
+		return -1, true
+	}
+
+	digits := tokens[j]
+	weekday := -1
+
+	switch len(digits) {
+	case 2:
+		j++
+		if j+1 < numTokens && tokens[j] == "-" && len(tokens[j+1]) == 1 && isDigits(tokens[j+1]) {
+			weekday, _ = strconv.Atoi(tokens[j+1])
+			j += 2
+		}
+
+	case 3:
+		weekday, _ = strconv.Atoi(digits[2:])
+		digits = digits[:2]
+		j++
+
+	default:
+		return -1, true
+	}
+
+	week, _ := strconv.Atoi(digits)
+	res.weekSpan = span{spans[i].start, spans[j-1].end}
+
+	if week < 1 || week > 53 || weekday == 0 || weekday > 7 {
+		return j, false
+	}
+
+	res.Week = week
+	if weekday != -1 {
+		res.Weekday = weekday % 7
+	}
+
+	return j, true
+}
+
+// Returns the number of ISO weeks in an ISO week-numbering year (52 or 53).
+func isoWeeksIn(year int) (weeks int) {
+	_, weeks = time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return weeks
+}
+
+// Returns the date of the given day of an ISO week. Week 1 is the week (from
+// Monday to Sunday) that contains the first Thursday of the year, or
+// equivalently 4 January.
+func isoWeekDate(year int, week int, weekday time.Weekday) (y int, m time.Month, d int) {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	day := (int(weekday) + 6) % 7
+
+	return monday.AddDate(0, 0, (week-1)*7+day).Date()
+}
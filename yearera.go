@@ -0,0 +1,83 @@
+package dateparser
+
+import (
+	"strings"
+)
+
+const (
+	_SUFFIX_NONE int = -1 + iota
+
+	_SUFFIX_YEAR
+	_SUFFIX_MONTH
+	_SUFFIX_DAY
+)
+
+// An era from which years are counted in place of the Christian era, such as
+// a Japanese era.
+type YearEra struct {
+	// The names of the era, as in "Heisei" and "平成". They may also be
+	// written with full stops between the letters.
+	Names []string
+
+	// The Gregorian year that is the first year of the era, in astronomical
+	// numbering.
+	Start int
+}
+
+// The eras used to count years in Japan (from Meiji onwards), in Taiwan (the
+// Minguo or ROC era) and in Thailand (the Buddhist Era, "พ.ศ."), with their
+// names in native script and in romanisation.
+var EastAsianEras = []YearEra{
+	{[]string{"meiji", "明治"}, 1868},
+	{[]string{"taisho", "taishō", "大正"}, 1912},
+	{[]string{"showa", "shōwa", "昭和"}, 1926},
+	{[]string{"heisei", "平成"}, 1989},
+	{[]string{"reiwa", "令和"}, 2019},
+	{[]string{"minguo", "roc", "民國", "民国", "中華民國", "中华民国"}, 1912},
+	{[]string{"พศ"}, -542},
+}
+
+// Returns the first year of the era named by the tokens starting at tokens[i],
+// and the index of the token following the name, or -1 if there is no such
+// era. first is true if the name is followed by a word for the first year of
+// the era (as in "平成元年"), in which case next follows that word.
+func yearEraAt(info *ParserInfo, tokens []string, i int) (start int, first bool, next int) {
+	numTokens := len(tokens)
+
+	era, next := dottedWordAt(info.yearEra, tokens, i)
+	if era != -1 {
+		j := next
+		if j < numTokens && tokens[j] == " " {
+			j++
+		}
+		if j < numTokens && info.firstYear.search(tokens[j]) != -1 {
+			return info.yearEraStarts[era], true, j + 1
+		}
+
+		return info.yearEraStarts[era], false, next
+	}
+
+	// The word for the first year may be written without a space, in which
+	// case it is part of the same token.
+	token := strings.ToLower(tokens[i])
+	for _, word := range info.firstYearWords {
+		if len(token) > len(word) && strings.HasSuffix(token, word) {
+			if era := info.yearEra.search(token[:len(token)-len(word)]); era != -1 {
+				return info.yearEraStarts[era], true, i + 1
+			}
+		}
+	}
+
+	return 0, false, -1
+}
+
+// Expands a year in the Thai Buddhist Era, which is 543 years ahead of the
+// Gregorian calendar, to a Gregorian year. As with convertYear, a two-digit
+// year is taken to be within 50 years of the current (Buddhist Era) year.
+func convertBuddhistYear(year int) (res int) {
+	if year < 100 {
+		return convertYear(floorMod(year-43, 100))
+	}
+
+	return year - 543
+}
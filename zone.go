@@ -0,0 +1,202 @@
+package dateparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// How Time handles a timezone in brackets whose offset at the written time
+// disagrees with the numeric offset before it, as in
+// "2003-09-25T10:36:28+05:00[Europe/Paris]".
+type ZoneMismatchPolicy int
+
+const (
+	// Time fails with a ParseError wrapping ErrZoneMismatch. This is the
+	// default.
+	ZoneMismatchError ZoneMismatchPolicy = iota
+
+	// Time keeps the instant given by the numeric offset, and returns it in
+	// the bracketed timezone.
+	ZoneMismatchUseOffset
+
+	// Time keeps the written date and time of day in the bracketed timezone,
+	// ignoring the numeric offset.
+	ZoneMismatchUseZone
+)
+
+// The areas that IANA timezone identifiers start with, as in "Europe/Paris",
+// including those of the older identifiers such as "US/Eastern".
+var zoneAreas = map[string]bool{
+	"Africa": true, "America": true, "Antarctica": true, "Arctic": true,
+	"Asia": true, "Atlantic": true, "Australia": true, "Europe": true,
+	"Indian": true, "Pacific": true, "Etc": true,
+	"Brazil": true, "Canada": true, "Chile": true, "Mexico": true, "US": true,
+}
+
+// Returns whether s is a word or number, and so may be part of a timezone
+// identifier.
+func isZoneWord(s string) (r bool) {
+	for _, char := range s {
+		if !unicode.IsLetter(char) && !unicode.IsDigit(char) {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Returns the IANA timezone identifier (such as "Europe/Paris" or
+// "America/Argentina/Buenos_Aires") starting at tokens[i], and the index of the
+// token following it, or -1 if there is none.
+func zoneIDAt(timestr string, tokens []string, spans []span, i int) (name string, next int) {
+	numTokens := len(tokens)
+	if !zoneAreas[tokens[i]] || i+2 >= numTokens || tokens[i+1] != "/" || !hasLetter(tokens[i+2]) {
+		return "", -1
+	}
+
+	// The identifier continues, without spaces, through words and the "/",
+	// "_", "-" and "+" that join them.
+	j := i + 3
+	for j+1 < numTokens && (tokens[j] == "/" || tokens[j] == "_" || tokens[j] == "-" || tokens[j] == "+") &&
+		isZoneWord(tokens[j+1]) && spans[j].start == spans[j-1].end && spans[j+1].start == spans[j].end {
+		j += 2
+	}
+
+	return timestr[spans[i].start:spans[j-1].end], j
+}
+
+// Returns the offset in seconds east of UTC of an identifier such as
+// "Etc/GMT-3", whose sign follows POSIX (so that "Etc/GMT-3" is three hours
+// ahead of UTC). ok is false if name is not one.
+func etcOffset(name string) (offset int, ok bool) {
+	rest, ok := strings.CutPrefix(name, "Etc/GMT")
+	if !ok {
+		return 0, false
+	}
+	if rest == "" || rest == "0" {
+		return 0, true
+	}
+
+	hours, err := strconv.Atoi(rest)
+	if err != nil || (rest[0] != '+' && rest[0] != '-') || hours < -14 || hours > 12 {
+		return 0, false
+	}
+
+	return -hours * 3600, true
+}
+
+// Parses an RFC 9557 suffix in brackets starting at tokens[i], as in
+// "[Europe/Paris]", "[+02:00]" or "[u-ca=hebrew]". A timezone is stored in
+// res.Zone; tags such as "u-ca=hebrew" are ignored unless they are marked as
+// critical with "!". Returns the index of the token following the suffix, or
+// -1 if tokens[i] does not start one. ok is false if the suffix cannot be
+// used.
+func (res *parseresult) parseZoneSuffix(timestr string, tokens []string, spans []span, i int) (next int, ok bool) {
+	if tokens[i] != "[" {
+		return -1, true
+	}
+
+	end := i + 1
+	for end < len(tokens) && tokens[end] != "]" {
+		end++
+	}
+	if end == len(tokens) || end == i+1 {
+		return -1, true
+	}
+
+	content := timestr[spans[i+1].start:spans[end-1].end]
+	critical := strings.HasPrefix(content, "!")
+	content = strings.TrimPrefix(content, "!")
+	res.zoneSpan = span{spans[i].start, spans[end].end}
+
+	if strings.Contains(content, "=") {
+		return end + 1, !critical
+	}
+	if res.Zone != "" || content == "" || strings.ContainsAny(content, " \t") {
+		return end + 1, false
+	}
+
+	res.Zone = content
+	res.zoneCritical = critical
+	return end + 1, true
+}
+
+// Returns the location of a timezone written in brackets, which is either an
+// IANA identifier or a numeric offset such as "+02:00".
+func (parser *Parser) zoneLocation(zone string, written time.Time) (loc *time.Location, err error) {
+	if zone[0] == '+' || zone[0] == '-' {
+		hours, minutes := 0, 0
+		n, _ := fmt.Sscanf(zone[1:], "%2d:%2d", &hours, &minutes)
+		if n != 2 || len(zone) != 6 {
+			return nil, fmt.Errorf("bad offset %q", zone)
+		}
+
+		offset := hours*3600 + minutes*60
+		if zone[0] == '-' {
+			offset = -offset
+		}
+		return time.FixedZone(zone, offset), nil
+	}
+
+	if offset, ok := etcOffset(zone); ok {
+		return time.FixedZone(zone, offset), nil
+	}
+
+	if parser.TZResolver != nil {
+		loc, err = parser.TZResolver.ResolveTZ(zone, written)
+		if loc != nil || err != nil {
+			return loc, err
+		}
+	}
+
+	return time.LoadLocation(zone)
+}
+
+// Moves t, which was built from the written date, time and offset, into
+// res.Zone: the timezone written in brackets after them, or named by a Windows
+// timezone name. hasInstant is whether the input had a timezone, so that t is
+// the intended instant; hasOffset is whether that timezone gave the local
+// offset, which must then agree with res.Zone according to
+// parser.ZoneMismatch. ("Z" gives the instant but says that the local offset
+// is unknown.)
+func (res Result) inZone(parser *Parser, t time.Time, hasInstant bool, hasOffset bool) (r time.Time, err error) {
+	where := res.timestr[res.zoneSpan.start:res.zoneSpan.end]
+
+	written := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	loc, err := parser.zoneLocation(res.Zone, written)
+	if err != nil {
+		perr := parser.errorAt(res.timestr, ErrUnknownTimezone, "Unknown timezone", where, res.zoneSpan)
+		perr.Err = fmt.Errorf("%w: %w", ErrUnknownTimezone, err)
+		return zeroTime, perr
+	}
+
+	inZone := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	switch {
+	case !hasInstant:
+		return inZone, nil
+	case !hasOffset:
+		return t.In(loc), nil
+	}
+
+	_, offset := t.Zone()
+	if _, zoneOffset := inZone.Zone(); zoneOffset == offset {
+		return inZone, nil
+	}
+
+	policy := parser.ZoneMismatch
+	if res.zoneCritical {
+		policy = ZoneMismatchError
+	}
+
+	switch policy {
+	case ZoneMismatchUseOffset:
+		return t.In(loc), nil
+	case ZoneMismatchUseZone:
+		return inZone, nil
+	}
+
+	return zeroTime, parser.errorAt(res.timestr, ErrZoneMismatch, "Timezone disagrees with offset", where, res.zoneSpan)
+}